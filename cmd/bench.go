@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/bench"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var benchCmd = &cobra.Command{
+	Use:   "bench [shorthand...]",
+	Short: "Benchmark the solutions of a year or a day",
+	Long: `Benchmark PartOne and PartTwo against testdata/input.txt with go test -bench.
+
+Without a day, every day of the year that has an input is benchmarked, e.g.:
+  bench y24, bench y24 d05, bench d05p2
+
+Each part is run --count times and the minimum, median and 95th percentile of the
+runs are reported together with the allocations. Results are recorded in
+.aoc/bench-history.json (or the --history file, as CSV if it ends with .csv), and
+parts whose median got --regression-factor times slower than last time are reported.
+Use --output json for the results as a table event and the regressions as warning events.`,
+	Args: cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		t, err := parseTarget(args)
+		if err != nil {
+			return err
+		}
+		t = t.orFlags()
+
+		workDir, err := workDirOrCwd()
+		if err != nil {
+			return err
+		}
+
+		b, err := bench.NewBenchmarker(bench.Config{
+			Year:      t.year,
+			Day:       t.day,
+			Part:      t.part,
+			WorkDir:   workDir,
+			Count:     viper.GetInt("count"),
+			BenchTime: viper.GetString("benchtime"),
+		})
+		if err != nil {
+			return invalidArgs(err)
+		}
+
+		if t.day > 0 {
+			reporter.Header("Benchmarking Advent of Code %d - Day %d", t.year, t.day)
+		} else {
+			reporter.Header("Benchmarking Advent of Code %d", t.year)
+		}
+
+		results, err := b.Run()
+		if err != nil {
+			return err
+		}
+
+		historyPath := viper.GetString("history")
+		if historyPath == "" {
+			historyPath = filepath.Join(workDir, bench.DefaultHistoryFile)
+		}
+		history, err := bench.LoadHistory(historyPath)
+		if err != nil {
+			return err
+		}
+		regressions := history.Regressions(results, viper.GetFloat64("regression-factor"))
+		if !viper.GetBool("no-history") {
+			if err := history.Append(time.Now(), results); err != nil {
+				return err
+			}
+		}
+
+		printBenchTable(reporter, results)
+		for _, r := range regressions {
+			reporter.Warning("%s", r)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(benchCmd)
+
+	year, _ := defaultYearDay()
+
+	benchCmd.Flags().IntP("day", "d", 0, "The day to benchmark, all days of the year if omitted")
+	benchCmd.Flags().IntP("year", "y", year, "The year of Advent of Code you are working on")
+	benchCmd.Flags().IntP("part", "p", 0, "The part to benchmark (1 or 2), both if omitted")
+
+	benchCmd.Flags().IntP("count", "n", bench.DefaultCount, "How often each part is run")
+	benchCmd.Flags().String("benchtime", bench.DefaultBenchTime, "The -benchtime of each run, e.g. 1x or 500ms")
+	benchCmd.Flags().String("history", "", "The history file (defaults to .aoc/bench-history.json in the working directory)")
+	benchCmd.Flags().Bool("no-history", false, "Don't record the results in the history")
+	benchCmd.Flags().Float64("regression-factor", bench.DefaultRegressionFactor, "How many times slower a part must get to be reported")
+	benchCmd.Flags().StringP("workdir", "w", "", "Your Advent of Code working directory (defaults to the current directory)")
+}
+
+func printBenchTable(reporter ui.Reporter, results []bench.Result) {
+	rows := make([][]string, 0, len(results))
+	for _, res := range results {
+		rows = append(rows, []string{
+			strconv.Itoa(res.Year),
+			strconv.Itoa(res.Day),
+			strconv.Itoa(res.Part),
+			strconv.Itoa(res.Runs),
+			formatDuration(res.Min),
+			formatDuration(res.Median),
+			formatDuration(res.P95),
+			formatSize(res.BytesPerOp),
+			strconv.FormatInt(res.AllocsPerOp, 10),
+		})
+	}
+	reporter.Table([]string{"Year", "Day", "Part", "Runs", "Min", "Median", "P95", "Memory", "Allocs"}, rows)
+}
+
+// formatDuration rounds d to a readable precision, e.g. 1.23ms.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(10 * time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(10 * time.Microsecond).String()
+	case d >= time.Microsecond:
+		return d.Round(10 * time.Nanosecond).String()
+	default:
+		return d.String()
+	}
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage downloaded inputs and puzzle pages",
+	Long: `Inputs and puzzle pages are cached, so recreating a day never downloads them twice.
+The cache lives in ~/.cache/aoc/{year}/{day} (or the platform's equivalent).`,
+}
+
+var cacheListCmd = &cobra.Command{
+	Use:   "list [y24]",
+	Short: "List cached files",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		t, err := parseTarget(args)
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+		cache := client.Cache()
+
+		entries, err := cache.List(t.year)
+		if err != nil {
+			return err
+		}
+
+		reporter.Info("Cache in %s", cache.Dir())
+		if len(entries) == 0 {
+			reporter.DimText("  No cached files")
+			return nil
+		}
+		for _, entry := range entries {
+			reporter.DimText("  %d day %2d  %-12s %8s  %s",
+				entry.Year, entry.Day, entry.Name, formatSize(entry.Size), entry.Modified.Format("2006-01-02 15:04"))
+		}
+		return nil
+	},
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear [y24 | y24d05]",
+	Short: "Remove cached files of a day, a year or everything",
+	Args:  cobra.MaximumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		t, err := parseTarget(args)
+		if err != nil {
+			return err
+		}
+		if t.year == 0 && !viper.GetBool("all") {
+			return fmt.Errorf("%w: select a year or day to clear, or use --all", errInvalidTarget)
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		if err := client.Cache().Clear(t.year, t.day); err != nil {
+			return err
+		}
+
+		switch {
+		case t.year == 0:
+			reporter.Success("Cleared the whole cache")
+		case t.day == 0:
+			reporter.Success("Cleared the cache of %d", t.year)
+		default:
+			reporter.Success("Cleared the cache of day %d of %d", t.day, t.year)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheListCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+
+	cacheClearCmd.Flags().Bool("all", false, "Clear the cache of all years")
+}
+
+// formatSize formats a file size in bytes for humans.
+func formatSize(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/spf13/viper"
+)
+
+// newClient creates the client all commands use to talk to adventofcode.com.
+func newClient() (*aoc.Client, error) {
+	return aoc.NewClient(aoc.Config{
+		BaseURL:   viper.GetString("base-url"),
+		Cookie:    viper.GetString("cookie"),
+		UserAgent: viper.GetString("user-agent"),
+	})
+}
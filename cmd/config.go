@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/config"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage the config file",
+	Long: `The config file stores defaults for flags that are not set on the command line.
+
+It is named aoc.toml (aoc.yaml and aoc.json work as well) and is searched for in the current
+directory first and in ~/.config/aoc (or the platform's equivalent) second. Use --config to
+point to another file.`,
+}
+
+var configInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Write a config file with the default settings",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		path, err := configPath()
+		if err != nil {
+			return err
+		}
+
+		workDir, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("failed to get working directory: %w", err)
+		}
+		year, _ := defaultYearDay()
+
+		values := map[string]any{
+			"workdir":      workDir,
+			"year":         year,
+			"template-dir": "",
+			"user-agent":   aoc.DefaultUserAgent,
+		}
+		if err := config.Init(path, values, viper.GetBool("force")); err != nil {
+			return err
+		}
+
+		reporter.FileCreated(path)
+		reporter.Success("Config file created!")
+		return nil
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get [key]",
+	Short: "Print the settings of the config file",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		if len(args) == 1 {
+			if _, err := config.Lookup(args[0]); err != nil {
+				return err
+			}
+			reporter.Value(args[0], viper.GetString(args[0]))
+			return nil
+		}
+
+		if file := viper.ConfigFileUsed(); file != "" {
+			reporter.Info("Config file %s", file)
+		} else {
+			reporter.Warning("No config file found")
+		}
+		for _, key := range config.Keys {
+			reporter.DimText("  %-14s %v", key.Name, viper.Get(key.Name))
+		}
+		return nil
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Change a setting of the config file",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		path := viper.ConfigFileUsed()
+		if path == "" {
+			return config.ErrNoConfigFile
+		}
+
+		if err := config.Set(path, args[0], args[1]); err != nil {
+			return err
+		}
+
+		reporter.Success("Set %s to %s in %s", args[0], args[1], ui.MakeRelative(path))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configInitCmd, configGetCmd, configSetCmd)
+
+	configInitCmd.Flags().Bool("local", false, "Write ./aoc.toml instead of the file in your config directory")
+	configInitCmd.Flags().BoolP("force", "f", false, "Replace an existing config file")
+}
+
+// configPath returns where config init writes the config file.
+func configPath() (string, error) {
+	if path := viper.GetString("config"); path != "" {
+		return path, nil
+	}
+	if viper.GetBool("local") {
+		return filepath.Abs(config.Name + "." + config.DefaultType)
+	}
+	return config.DefaultPath()
+}
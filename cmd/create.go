@@ -3,37 +3,116 @@ package cmd
 import (
 	"errors"
 	"fmt"
-	"time"
 
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
 	"github.com/frederik-suerig/advent-of-code/internal/create"
+	"github.com/frederik-suerig/advent-of-code/internal/puzzle"
+	"github.com/frederik-suerig/advent-of-code/internal/submit"
 	"github.com/frederik-suerig/advent-of-code/internal/ui"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var createCmd = &cobra.Command{
-	Use:   "create",
+	Use:   "create [shorthand...]",
 	Short: "Generate code for a new day",
-	Args:  cobra.NoArgs,
+	Long: `Generate code for a new day and download its input.
+
+The day can be selected with the --year and --day flags or with shorthands like y24d14 or y24 d14.
+The puzzle description is stored as README.md in the day's directory. Its example inputs are
+stored in testdata/ and checked by TestPartOne and TestPartTwo. Use --refresh after solving
+part one to add the description and examples of part two.
+
+Use --template-dir (or template-dir in the config file) to replace the built-in templates. Every
+file in it is rendered with text/template into the day's directory, without its .tmpl suffix.
+Templates can use {{.Year}}, {{.Day}}, {{.PaddedDay}}, {{.Package}}, {{.Title}}, {{.ModulePath}}
+and {{.InputPath}}. The examples are still stored in testdata/, but TestPartOne and TestPartTwo
+are not generated. A day with a solution.go is added to solutions/registry_gen.go if
+its package declares the functions PartOne and PartTwo; other days are left out with a warning.
+
+Use --wait shortly before a puzzle is released to create the day the moment it unlocks.
+
+If the day already exists, you are asked whether to recreate it. Use --force to recreate it
+or --skip-existing to keep it without asking. With --no-input, or if stdin is not a terminal,
+create fails instead of asking. The exit code tells failures apart: 2 for invalid arguments,
+3 if the day already exists, 4 if a download failed and 130 if a prompt was cancelled.
+
+Use --days 1-25 or --all to create several days at once. Existing days are skipped unless
+--force is given, days that are not released yet are created without downloads, and a
+summary of the created, skipped and failed days is printed at the end.
+
+Downloaded inputs are checked before they are written: an empty body, an HTML page, a request
+to log in or to stop requesting too often, or an input without a trailing newline fail the
+download. Use --verify to check the existing inputs of a year the same way.`,
+	Args: cobra.ArbitraryArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		t, err := parseTarget(args)
+		if err != nil {
+			return err
+		}
+		t = t.orFlags()
+
+		if viper.GetBool("force") && viper.GetBool("skip-existing") {
+			return invalidArgs(errors.New("--force and --skip-existing cannot be used together"))
+		}
+
+		if viper.GetBool("verify") {
+			return verifyInputs(reporter, t.year)
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
 		cfg := create.Config{
-			Year:    viper.GetInt("year"),
-			Day:     viper.GetInt("day"),
-			WorkDir: viper.GetString("workdir"),
-			Cookie:  viper.GetString("cookie"),
+			Year:        t.year,
+			Day:         t.day,
+			WorkDir:     viper.GetString("workdir"),
+			TemplateDir: viper.GetString("template-dir"),
+			Client:      client,
+			Heuristics: puzzle.Heuristics{
+				MinLines:        viper.GetInt("example-min-lines"),
+				AllBlocks:       viper.GetBool("all-examples"),
+				MaxAnswerLength: viper.GetInt("example-max-answer-length"),
+				NumericAnswers:  viper.GetBool("numeric-answers"),
+			},
+			Force:    viper.GetBool("force"),
+			NoInput:  viper.GetBool("skip-existing") || !interactive(),
+			Wait:     viper.GetBool("wait"),
+			Reporter: reporter,
+		}
+
+		if viper.GetString("days") != "" || viper.GetBool("all") {
+			return createDays(cfg)
 		}
 
 		g, err := create.NewGenerator(cfg)
 		if err != nil {
-			return formatError(err)
+			return invalidArgs(formatError(err))
+		}
+
+		if viper.GetBool("refresh") {
+			if err := g.Refresh(); err != nil {
+				return formatError(err)
+			}
+
+			reporter.Success("Puzzle description refreshed!")
+			return nil
 		}
 
 		if err := g.Run(); err != nil {
+			if errors.Is(err, create.ErrDayExists) && viper.GetBool("skip-existing") {
+				reporter.Info("Day %d of %d already exists, skipping it", cfg.Day, cfg.Year)
+				return nil
+			}
 			return formatError(err)
 		}
 
-		ui.Success("All files created successfully!")
-		ui.HighlightInfo("You can now start solving the puzzle in: ./y%04d/d%02d", cfg.Year, cfg.Day)
+		reporter.Success("All files created successfully!")
+		reporter.HighlightInfo("You can now start solving the puzzle in: ./y%04d/d%02d", cfg.Year, cfg.Day)
 
 		return nil
 	},
@@ -43,20 +122,119 @@ func init() {
 	rootCmd.AddCommand(createCmd)
 
 	// Year defaults to latest Advent of Code.
-	year, month, day := time.Now().Date()
-	if month < time.December {
-		year--
-	}
+	year, day := defaultYearDay()
 
 	createCmd.Flags().IntP("day", "d", day, "The day to build scaffolding for")
 	createCmd.Flags().IntP("year", "y", year, "The year of Advent of Code you are working on")
 
 	createCmd.Flags().StringP("workdir", "w", "", "Your Advent of Code working directory")
+	createCmd.Flags().String("template-dir", "", "A directory whose files are rendered into the day instead of the built-in templates")
 	createCmd.Flags().StringP("cookie", "c", "", "Your session cookie for adventofcode.com")
+	createCmd.Flags().Int("example-min-lines", 1, "Minimum number of lines of a code block in the puzzle to use it as an example")
+	createCmd.Flags().Bool("all-examples", false, "Use every code block of the puzzle as an example instead of only the first of each part")
+	createCmd.Flags().Int("example-max-answer-length", 0, "Ignore highlighted values longer than this when detecting expected answers (0 means no limit)")
+	createCmd.Flags().Bool("numeric-answers", false, "Only accept integers as expected answers of examples")
+	createCmd.Flags().Bool("wait", false, "Wait until the puzzle unlocks at midnight US Eastern time, then create the day")
+	createCmd.Flags().Bool("refresh", false, "Download the puzzle description of an existing day again, e.g. after solving part one")
+	createCmd.Flags().BoolP("force", "f", false, "Delete and recreate the day without asking if it already exists")
+	createCmd.Flags().Bool("skip-existing", false, "Leave the day untouched and succeed if it already exists")
+	createCmd.Flags().String("days", "", "Create several days instead of --day, e.g. 1-25 or 1,3,5-7")
+	createCmd.Flags().Bool("all", false, "Create all days of the year")
+	createCmd.Flags().Bool("verify", false, "Check the existing inputs of the year instead of creating a day")
+}
+
+// createDays creates the days selected with --days or --all and prints a summary.
+func createDays(cfg create.Config) error {
+	if viper.GetBool("refresh") || viper.GetBool("wait") {
+		return invalidArgs(errors.New("--refresh and --wait only work with a single day"))
+	}
+
+	var days []int
+	if viper.GetBool("all") {
+		for day := 1; day <= aoc.DaysInYear(cfg.Year); day++ {
+			days = append(days, day)
+		}
+	} else {
+		var err error
+		if days, err = parseDays(viper.GetString("days")); err != nil {
+			return err
+		}
+	}
+
+	results, err := create.RunDays(cfg, days)
+	if err != nil {
+		return invalidArgs(formatError(err))
+	}
+
+	var rows [][]string
+	var created, skipped int
+	var failed []create.DayResult
+	for _, res := range results {
+		switch res.Outcome {
+		case create.Created:
+			created++
+		case create.Skipped:
+			skipped++
+		case create.Failed:
+			failed = append(failed, res)
+		}
+		rows = append(rows, []string{fmt.Sprintf("%d", res.Day), res.Outcome.String(), res.Reason})
+	}
 
-	if err := viper.BindPFlags(createCmd.Flags()); err != nil {
-		panic(fmt.Errorf("failed to bind flags: %w", err))
+	cfg.Reporter.Header("Advent of Code %d", cfg.Year)
+	cfg.Reporter.Table([]string{"Day", "Status", "Details"}, rows)
+
+	if len(failed) > 0 {
+		// The exit code follows the first failure
+		return &displayError{
+			message: fmt.Sprintf("%d of %d days failed", len(failed), len(results)),
+			err:     failed[0].Err,
+		}
 	}
+
+	cfg.Reporter.Success("Created %d days, skipped %d", created, skipped)
+	return nil
+}
+
+// verifyInputs checks the existing inputs of a year and prints a summary.
+func verifyInputs(reporter ui.Reporter, year int) error {
+	workDir, err := workDirOrCwd()
+	if err != nil {
+		return err
+	}
+
+	checks, err := create.VerifyInputs(afero.NewOsFs(), workDir, year)
+	if err != nil {
+		return invalidArgs(formatError(err))
+	}
+	if len(checks) == 0 {
+		reporter.Info("No inputs found for %d", year)
+		return nil
+	}
+
+	var rows [][]string
+	var invalid []create.InputCheck
+	for _, check := range checks {
+		status, details := "ok", ui.MakeRelative(check.Path)
+		if check.Err != nil {
+			invalid = append(invalid, check)
+			status, details = "invalid", formatError(check.Err).Error()
+		}
+		rows = append(rows, []string{fmt.Sprintf("%d", check.Day), status, details})
+	}
+
+	reporter.Header("Inputs of Advent of Code %d", year)
+	reporter.Table([]string{"Day", "Status", "Details"}, rows)
+
+	if len(invalid) > 0 {
+		return &displayError{
+			message: fmt.Sprintf("%d of %d inputs are invalid - recreate them with --force", len(invalid), len(checks)),
+			err:     invalid[0].Err,
+		}
+	}
+
+	reporter.Success("All %d inputs are valid", len(checks))
+	return nil
 }
 
 // formatError formats errors for user-friendly display
@@ -65,22 +243,31 @@ func formatError(err error) error {
 		return nil
 	}
 
-	// Handle cancellation message - don't format it, just return as-is
-	errMsg := err.Error()
-	if errMsg == "operation cancelled by user" {
+	// Cancelling is not a failure worth explaining, return it as-is
+	if errors.Is(err, ui.ErrCancelled) {
 		return err
 	}
 
 	// Handle custom error types
 	var fileExistsErr *create.FileExistsError
 	if errors.As(err, &fileExistsErr) {
-		return fmt.Errorf("%s", fileExistsErr.UserMessage())
+		return &displayError{message: fileExistsErr.UserMessage(), err: err}
+	}
+
+	if errors.Is(err, create.ErrDayExists) {
+		return &displayError{message: fmt.Sprintf("%s - use --force to recreate it or --skip-existing to keep it", err), err: err}
 	}
 
 	var downloadErr *create.DownloadError
 	if errors.As(err, &downloadErr) {
 		// For download errors, return the reason without status code for cleaner output
-		return fmt.Errorf("%s", downloadErr.Reason)
+		return &displayError{message: downloadErr.Reason, err: err}
+	}
+
+	var submitErr *submit.SubmitError
+	if errors.As(err, &submitErr) {
+		// Same as download errors, the status code is only noise for the user
+		return &displayError{message: submitErr.Reason, err: err}
 	}
 
 	// For other errors, return as-is (they're already user-friendly)
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/frederik-suerig/advent-of-code/internal/create"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+)
+
+// Exit codes, so scripts can tell failures apart
+const (
+	exitFailure        = 1
+	exitInvalidArgs    = 2
+	exitAlreadyExists  = 3
+	exitDownloadFailed = 4
+	// exitCancelled is what a shell reports for a process stopped with Ctrl-C
+	exitCancelled = 130
+)
+
+// invalidArgsError marks an error caused by invalid flags or arguments
+type invalidArgsError struct {
+	err error
+}
+
+func (e *invalidArgsError) Error() string {
+	return e.err.Error()
+}
+
+func (e *invalidArgsError) Unwrap() error {
+	return e.err
+}
+
+// invalidArgs marks err as caused by invalid flags or arguments.
+func invalidArgs(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &invalidArgsError{err: err}
+}
+
+// displayError replaces the message of an error while keeping it for exitCode
+type displayError struct {
+	message string
+	err     error
+}
+
+func (e *displayError) Error() string {
+	return e.message
+}
+
+func (e *displayError) Unwrap() error {
+	return e.err
+}
+
+// exitCode returns the exit code for an error returned by a command.
+func exitCode(err error) int {
+	var invalidArgsErr *invalidArgsError
+	var fileExistsErr *create.FileExistsError
+	var downloadErr *create.DownloadError
+
+	switch {
+	case errors.As(err, &invalidArgsErr), errors.Is(err, errInvalidTarget), errors.Is(err, errInvalidDays):
+		return exitInvalidArgs
+	case errors.Is(err, create.ErrDayExists), errors.As(err, &fileExistsErr):
+		return exitAlreadyExists
+	case errors.As(err, &downloadErr):
+		return exitDownloadFailed
+	case errors.Is(err, ui.ErrCancelled):
+		return exitCancelled
+	default:
+		return exitFailure
+	}
+}
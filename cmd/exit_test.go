@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/frederik-suerig/advent-of-code/internal/create"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"Invalid shorthand", fmt.Errorf("%w: %q", errInvalidTarget, "x"), exitInvalidArgs},
+		{"Invalid flag", invalidArgs(create.ErrInvalidDay), exitInvalidArgs},
+		{"Day exists", formatError(fmt.Errorf("%w: ./y2024/d05", create.ErrDayExists)), exitAlreadyExists},
+		{"Input exists", formatError(create.NewFileExistsError("y2024/d05/testdata/input.txt")), exitAlreadyExists},
+		{"Download failed", formatError(create.NewDownloadError("puzzle not available", 404)), exitDownloadFailed},
+		{"Cancelled", formatError(ui.ErrCancelled), exitCancelled},
+		{"Cancelled login prompt", ui.ErrCancelled, exitCancelled},
+		{"Other", errors.New("boom"), exitFailure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCode(tt.err); got != tt.expected {
+				t.Errorf("exitCode(%v) = %d, want %d", tt.err, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/leaderboard"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var leaderboardCmd = &cobra.Command{
+	Use:   "leaderboard [id] [shorthand...]",
+	Short: "Show a private leaderboard and what changed since the last check",
+	Long: `Show the ranking of a private leaderboard with the stars of every member.
+
+The ID is the number at the end of the leaderboard's URL. Without an ID, the
+leaderboard from the config file is shown (aoc config set leaderboard <id>).
+Select a day, e.g. with d05, to see when each member solved both parts and how
+long part two took them.
+
+Each check is stored as a snapshot to show what changed since the last check.
+The leaderboard is downloaded at most once every 15 minutes, as asked by adventofcode.com.`,
+	Args: cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		id := viper.GetInt("leaderboard")
+		if len(args) > 0 {
+			if parsed, err := strconv.Atoi(args[0]); err == nil {
+				id = parsed
+				args = args[1:]
+			}
+		}
+
+		t, err := parseTarget(args)
+		if err != nil {
+			return err
+		}
+		t = t.orFlags()
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		v, err := leaderboard.NewViewer(leaderboard.Config{Year: t.year, ID: id, Client: client})
+		if err != nil {
+			return invalidArgs(err)
+		}
+
+		report, err := v.Check()
+		if err != nil {
+			var requestErr *aoc.RequestError
+			if errors.As(err, &requestErr) {
+				return fmt.Errorf("%s", requestErr.Reason)
+			}
+			return err
+		}
+
+		reporter.Header("Private Leaderboard %d - #%d", t.year, id)
+		reporter.DimText("  Downloaded %s ago, at most once every %s\n", formatAge(time.Since(report.Fetched)), aoc.LeaderboardTTL)
+
+		if t.day > 0 {
+			printLeaderboardDay(reporter, report.Board, t.year, t.day)
+		} else {
+			printRanking(reporter, report.Board, t.year)
+		}
+
+		switch {
+		case report.Previous == nil:
+			reporter.Info("First check - changes will be shown from now on")
+		case len(report.Changes) == 0:
+			reporter.Info("No changes since %s ago", formatAge(time.Since(report.PreviousChecked)))
+		default:
+			reporter.Info("Since the last check %s ago:", formatAge(time.Since(report.PreviousChecked)))
+			for _, c := range report.Changes {
+				reporter.DimText("  • %s", c)
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(leaderboardCmd)
+
+	year, _ := defaultYearDay()
+
+	leaderboardCmd.Flags().IntP("year", "y", year, "The year of the leaderboard")
+	leaderboardCmd.Flags().IntP("day", "d", 0, "Show the completion times of a day instead of the ranking")
+	leaderboardCmd.Flags().StringP("cookie", "c", "", "Your session cookie for adventofcode.com")
+}
+
+func printRanking(reporter ui.Reporter, board *leaderboard.Leaderboard, year int) {
+	days := aoc.DaysInYear(year)
+
+	var rows [][]string
+	for i, m := range board.Ranking() {
+		var stars strings.Builder
+		for day := 1; day <= days; day++ {
+			switch m.StarCount(day) {
+			case 2:
+				stars.WriteString("★")
+			case 1:
+				stars.WriteString("☆")
+			default:
+				stars.WriteString("·")
+			}
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(i + 1),
+			m.DisplayName(),
+			strconv.Itoa(m.LocalScore),
+			strconv.Itoa(m.Stars),
+			stars.String(),
+		})
+	}
+	reporter.Table([]string{"#", "Name", "Score", "Stars", "Days"}, rows)
+}
+
+func printLeaderboardDay(reporter ui.Reporter, board *leaderboard.Leaderboard, year, day int) {
+	var members []leaderboard.Member
+	for _, m := range board.Ranking() {
+		if m.StarCount(day) > 0 {
+			members = append(members, m)
+		}
+	}
+	// Fastest part two first, then those who only solved part one
+	sort.SliceStable(members, func(i, j int) bool {
+		a, aOK := members[i].Completion(year, day, 2)
+		b, bOK := members[j].Completion(year, day, 2)
+		if aOK != bOK {
+			return aOK
+		}
+		if aOK {
+			return a < b
+		}
+		a, _ = members[i].Completion(year, day, 1)
+		b, _ = members[j].Completion(year, day, 1)
+		return a < b
+	})
+
+	var rows [][]string
+	for i, m := range members {
+		row := []string{strconv.Itoa(i + 1), m.DisplayName()}
+		for part := 1; part <= 2; part++ {
+			if d, ok := m.Completion(year, day, part); ok {
+				row = append(row, formatClock(d))
+			} else {
+				row = append(row, "-")
+			}
+		}
+		if d, ok := m.Delta(day); ok {
+			row = append(row, "+"+formatClock(d))
+		} else {
+			row = append(row, "-")
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		reporter.DimText("  Nobody has solved day %d yet", day)
+		return
+	}
+	reporter.Table([]string{"#", "Name", "Part 1", "Part 2", "Delta"}, rows)
+}
+
+// formatClock formats a duration as hh:mm:ss, prefixed by the days if it is longer than a day.
+func formatClock(d time.Duration) string {
+	d = d.Round(time.Second)
+	hours := int(d.Hours())
+	clock := fmt.Sprintf("%02d:%02d:%02d", hours%24, int(d.Minutes())%60, int(d.Seconds())%60)
+	if hours >= 24 {
+		return fmt.Sprintf("%dd %s", hours/24, clock)
+	}
+	return clock
+}
+
+// formatAge formats the age of a download, e.g. 3m0s.
+func formatAge(d time.Duration) string {
+	return d.Round(time.Second).String()
+}
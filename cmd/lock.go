@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/frederik-suerig/advent-of-code/internal/lock"
+	"github.com/frederik-suerig/advent-of-code/internal/runner"
+	"github.com/frederik-suerig/advent-of-code/internal/submit"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var lockCmd = &cobra.Command{
+	Use:   "lock [shorthand...]",
+	Short: "Set the expected output of a solved part in solution_test.go",
+	Long: `Run a part and, once you confirm its answer, write it into the // Output: comment
+of ExamplePartOne or ExamplePartTwo in the day's solution_test.go, e.g.:
+  lock y24d05p1, lock d05 (both parts)
+
+From then on go test fails if a change to the solution breaks the answer.
+The answer is compared to the correct one in answers.json, if it was submitted. An answer
+that differs from it is only locked if you confirm it again, even with --yes, or with
+--allow-mismatch.`,
+	Args: cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		t, err := parseTarget(args)
+		if err != nil {
+			return err
+		}
+		t = t.orFlags()
+
+		workDir, err := workDirOrCwd()
+		if err != nil {
+			return err
+		}
+
+		r, err := runner.NewRunner(runner.Config{
+			Year:    t.year,
+			Day:     t.day,
+			Part:    t.part,
+			WorkDir: workDir,
+			Rebuild: viper.GetBool("rebuild"),
+			Stdout:  solutionOutput(),
+		})
+		if err != nil {
+			return invalidArgs(formatError(err))
+		}
+
+		reporter.Header("Locking Advent of Code %d - Day %d", t.year, t.day)
+
+		results, err := r.Run()
+		if err != nil {
+			return formatError(err)
+		}
+
+		dayDir := filepath.Join(workDir, fmt.Sprintf("y%04d", t.year), fmt.Sprintf("d%02d", t.day))
+		answers, err := submit.LoadHistory(filepath.Join(dayDir, submit.AnswersFile))
+		if err != nil {
+			return err
+		}
+		testFile := filepath.Join(dayDir, "solution_test.go")
+
+		for _, res := range results {
+			reporter.Answer(res.Part, res.Answer, res.Duration)
+			if res.Answer == "" {
+				return fmt.Errorf("part %d printed no answer", res.Part)
+			}
+
+			correct, submitted := answers.Correct(res.Part)
+			mismatch := submitted && correct != res.Answer
+			switch {
+			case mismatch:
+				reporter.Warning("The accepted answer of part %d is %s", res.Part, correct)
+			case submitted:
+				reporter.DimText("  Matches the answer accepted by adventofcode.com")
+			}
+
+			switch {
+			case mismatch && !viper.GetBool("allow-mismatch"):
+				// --yes is not enough, as the wrong answer would become the expected output
+				if !interactive() {
+					return fmt.Errorf("part %d answered %s, but adventofcode.com accepted %s - use --allow-mismatch to lock it anyway",
+						res.Part, res.Answer, correct)
+				}
+				if err := confirmLock(fmt.Sprintf("Lock %s as the expected output of part %d, although adventofcode.com accepted %s? (y/N)",
+					res.Answer, res.Part, correct)); err != nil {
+					return err
+				}
+			case !viper.GetBool("yes"):
+				if !interactive() {
+					return invalidArgs(errors.New("cannot ask for confirmation - use --yes to lock without asking"))
+				}
+				if err := confirmLock(fmt.Sprintf("Lock %s as the expected output of part %d? (y/N)", res.Answer, res.Part)); err != nil {
+					return err
+				}
+			}
+
+			if err := lock.SetOutput(testFile, lock.ExampleName(res.Part), res.Answer); err != nil {
+				return err
+			}
+			reporter.Success("Locked part %d in %s", res.Part, ui.MakeRelative(testFile))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(lockCmd)
+
+	year, day := defaultYearDay()
+
+	lockCmd.Flags().IntP("day", "d", day, "The day to lock")
+	lockCmd.Flags().IntP("year", "y", year, "The year of Advent of Code you are working on")
+	lockCmd.Flags().IntP("part", "p", 0, "The part to lock (1 or 2), both if omitted")
+
+	lockCmd.Flags().Bool("yes", false, "Lock the answers without asking")
+	lockCmd.Flags().Bool("allow-mismatch", false, "Also lock answers that differ from the one accepted by adventofcode.com")
+	lockCmd.Flags().Bool("rebuild", false, "Compile the solution from source instead of using the one built into this binary")
+	lockCmd.Flags().StringP("workdir", "w", "", "Your Advent of Code working directory (defaults to the current directory)")
+}
+
+// confirmLock asks the user to confirm locking an answer and returns ui.ErrCancelled if they decline.
+func confirmLock(label string) error {
+	ok, err := ui.Confirm(label)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ui.ErrCancelled
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/config"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var loginCmd = &cobra.Command{
+	Use:   "login [cookie]",
+	Short: "Store your session cookie",
+	Long: `Store your adventofcode.com session cookie, so it doesn't have to be passed to every command.
+
+The cookie is the value of the "session" cookie of adventofcode.com in your browser.
+It can be passed as an argument, piped in or entered at the prompt. It is checked
+against the website and then stored in ~/.config/aoc/session, readable only by you.
+--cookie and AOC_COOKIE still take precedence over the stored cookie.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		cookie, err := readCookie(args)
+		if err != nil {
+			return err
+		}
+
+		client, err := aoc.NewClient(aoc.Config{
+			BaseURL:   viper.GetString("base-url"),
+			Cookie:    cookie,
+			UserAgent: viper.GetString("user-agent"),
+		})
+		if err != nil {
+			return err
+		}
+
+		reporter.Info("Checking session cookie")
+		if err := client.CheckSession(); err != nil {
+			var requestErr *aoc.RequestError
+			if errors.As(err, &requestErr) {
+				return fmt.Errorf("%s", requestErr.Reason)
+			}
+			return err
+		}
+
+		if err := config.SaveSession(cookie); err != nil {
+			return err
+		}
+
+		path, err := config.SessionPath()
+		if err != nil {
+			return err
+		}
+		reporter.FileCreated(path)
+		reporter.Success("Logged in!")
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(loginCmd)
+}
+
+// readCookie returns the cookie from the arguments, stdin or a prompt.
+func readCookie(args []string) (string, error) {
+	if len(args) == 1 {
+		return strings.TrimSpace(args[0]), nil
+	}
+
+	if !stdinIsTerminal() {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read cookie from stdin: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	if !interactive() {
+		return "", invalidArgs(errors.New("no cookie given - pass it as an argument or pipe it in"))
+	}
+
+	cookie, err := ui.PromptSecret("Session cookie")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(cookie), nil
+}
@@ -1,10 +1,18 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"os"
+	"time"
 
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/config"
 	"github.com/frederik-suerig/advent-of-code/internal/ui"
+	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var rootCmd = &cobra.Command{
@@ -14,12 +22,102 @@ var rootCmd = &cobra.Command{
 	Long:          `Advent of Code is a series of programming puzzles. This CLI helps you with creating scaffolding and helper functions for the puzzles.`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// Only bind the flags of the command being run, as several commands
+		// share flag names like --year and --day.
+		if err := viper.BindPFlags(cmd.Flags()); err != nil {
+			return fmt.Errorf("failed to bind flags: %w", err)
+		}
+
+		reporter, err := ui.NewReporter(viper.GetString("output"), viper.GetBool("quiet"))
+		if err != nil {
+			return invalidArgs(err)
+		}
+		cmd.SetContext(context.WithValue(cmd.Context(), reporterKey{}, reporter))
+
+		// Flags that are not set fall back to the config file and the cookie to the one saved by login.
+		if err := config.Load(viper.GetViper(), viper.GetString("config")); err != nil {
+			return err
+		}
+		cookie, err := config.LoadSession()
+		if err != nil {
+			return err
+		}
+		viper.SetDefault("cookie", cookie)
+		return nil
+	},
 }
 
+func init() {
+	rootCmd.PersistentFlags().String("user-agent", aoc.DefaultUserAgent, "The User-Agent sent to adventofcode.com, ideally with a way to contact you")
+	rootCmd.PersistentFlags().String("config", "", "The config file to use instead of ./aoc.toml or ~/.config/aoc/aoc.toml")
+	rootCmd.PersistentFlags().String("base-url", aoc.DefaultBaseURL, "The Advent of Code website to talk to")
+	rootCmd.PersistentFlags().Bool("no-input", false, "Never prompt, fail instead (the default if stdin is not a terminal)")
+	rootCmd.PersistentFlags().StringP("output", "o", ui.FormatText, "The output format: text, or json for one event per line")
+	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Only print errors and answers")
+
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return invalidArgs(err)
+	})
+
+	// The session cookie can also be provided through the environment.
+	if err := viper.BindEnv("cookie", "AOC_COOKIE"); err != nil {
+		panic(fmt.Errorf("failed to bind environment: %w", err))
+	}
+}
+
+// reporterKey is the context key of the reporter built for the command being run.
+type reporterKey struct{}
+
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	if cmd, err := rootCmd.ExecuteC(); err != nil {
 		// Extract the underlying error message for cleaner output
-		ui.Error("%s", err)
-		os.Exit(1)
+		reporterOf(cmd).Error("%s", err)
+		os.Exit(exitCode(err))
+	}
+}
+
+// reporterOf returns the reporter for the --output and --quiet flags of cmd. Errors in
+// the flags themselves are reported before it is built, on the terminal.
+func reporterOf(cmd *cobra.Command) ui.Reporter {
+	if cmd != nil && cmd.Context() != nil {
+		if reporter, ok := cmd.Context().Value(reporterKey{}).(ui.Reporter); ok {
+			return reporter
+		}
+	}
+	return ui.NewTerminal(os.Stdout, os.Stderr)
+}
+
+// interactive returns true if the user can be prompted: --no-input is not set, the output
+// is meant for people and stdin is a terminal.
+func interactive() bool {
+	if viper.GetBool("no-input") || viper.GetString("output") == ui.FormatJSON {
+		return false
+	}
+	return stdinIsTerminal()
+}
+
+// solutionOutput returns where solutions print to: stdout, unless it is reserved for
+// JSON events or, with --quiet, for the answers alone.
+func solutionOutput() io.Writer {
+	if viper.GetString("output") == ui.FormatJSON || viper.GetBool("quiet") {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+// stdinIsTerminal returns true if stdin is a terminal, and not e.g. a pipe or /dev/null.
+func stdinIsTerminal() bool {
+	fd := os.Stdin.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// defaultYearDay returns the latest Advent of Code year and today's day of month.
+// Outside of December the previous year is the latest one.
+func defaultYearDay() (year, day int) {
+	year, month, day := time.Now().Date()
+	if month < time.December {
+		year--
 	}
+	return year, day
 }
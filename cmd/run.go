@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/frederik-suerig/advent-of-code/internal/runner"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run [shorthand...]",
+	Short: "Run the solution of a day against its input",
+	Long: `Run PartOne and PartTwo of a day against testdata/input.txt and print the answers.
+
+The puzzle can be selected with shorthands, e.g.:
+  y24d14p2, y2024d14, d14p2, d14, p1
+  y24 d14 p2, d14 p2
+
+Anything not given defaults to the latest year, today's day and both parts.
+
+Days are compiled on the fly. A binary built with -tags registry runs the days of
+its solution registry in-process instead, except with --rebuild; days created after
+it was built are still compiled on the fly.`,
+	Args: cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		t, err := parseTarget(args)
+		if err != nil {
+			return err
+		}
+		t = t.orFlags()
+
+		workDir, err := workDirOrCwd()
+		if err != nil {
+			return err
+		}
+
+		r, err := runner.NewRunner(runner.Config{
+			Year:    t.year,
+			Day:     t.day,
+			Part:    t.part,
+			WorkDir: workDir,
+			Rebuild: viper.GetBool("rebuild"),
+			Stdout:  solutionOutput(),
+		})
+		if err != nil {
+			return invalidArgs(formatError(err))
+		}
+
+		reporter.Header("Running Advent of Code %d - Day %d", t.year, t.day)
+
+		results, err := r.Run()
+		for _, res := range results {
+			reporter.Answer(res.Part, res.Answer, res.Duration)
+		}
+		if err != nil {
+			return formatError(err)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(runCmd)
+
+	year, day := defaultYearDay()
+
+	runCmd.Flags().IntP("day", "d", day, "The day to run")
+	runCmd.Flags().IntP("year", "y", year, "The year of Advent of Code you are working on")
+	runCmd.Flags().IntP("part", "p", 0, "The part to run (1 or 2), both if omitted")
+
+	runCmd.Flags().Bool("rebuild", false, "Compile the solution from source instead of using the one built into this binary")
+	runCmd.Flags().StringP("workdir", "w", "", "Your Advent of Code working directory (defaults to the current directory)")
+}
+
+// workDirOrCwd returns the --workdir flag, falling back to the current working directory.
+func workDirOrCwd() (string, error) {
+	if workDir := viper.GetString("workdir"); workDir != "" {
+		return workDir, nil
+	}
+	workDir, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get working directory: %w", err)
+	}
+	return workDir, nil
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/status"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status [y24]",
+	Short: "Show the progress of a year as a calendar",
+	Long: `Show which days of a year exist, which parts are solved and how fast they are.
+
+A part counts as solved when its example in solution_test.go has a filled-in
+// Output: comment or answers.json has a correct answer for it (green star).
+With a session cookie the stars earned on adventofcode.com are shown as well
+(gold star). Timings are the medians of the latest 'bench' run of both parts.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		t, err := parseYearTarget(args)
+		if err != nil {
+			return err
+		}
+		t = t.orFlags()
+
+		workDir, err := workDirOrCwd()
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		s, err := status.NewScanner(status.Config{
+			Year:     t.year,
+			WorkDir:  workDir,
+			Client:   client,
+			Reporter: reporter,
+		})
+		if err != nil {
+			return invalidArgs(err)
+		}
+
+		reporter.Header("Advent of Code %d", t.year)
+
+		days, err := s.Scan()
+		if err != nil {
+			return err
+		}
+
+		cells := make([]ui.CalendarDay, 0, len(days))
+		stars, solved := 0, 0
+		for _, day := range days {
+			cell := ui.CalendarDay{Day: day.Day, Exists: day.Exists, Stars: day.Stars()}
+			var total time.Duration
+			for _, part := range day.Parts {
+				if part.Solved {
+					cell.Solved++
+				}
+				total += part.Duration
+			}
+			if total > 0 {
+				cell.Detail = formatDuration(total)
+			}
+			if day.Exists && !day.HasInput {
+				cell.Warning = "no input"
+			}
+
+			stars += cell.Stars
+			solved += cell.Solved
+			cells = append(cells, cell)
+		}
+
+		columns := 5
+		if len(days) < 25 {
+			columns = 4
+		}
+		reporter.Calendar(cells, columns)
+
+		if client.HasCookie() {
+			reporter.Info("%d of %d stars on adventofcode.com, %d parts solved locally", stars, 2*len(days), solved)
+		} else {
+			reporter.Info("%d of %d parts solved locally", solved, 2*len(days))
+			reporter.DimText("  Log in or provide a cookie to see your stars on adventofcode.com")
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+
+	year, _ := defaultYearDay()
+
+	statusCmd.Flags().IntP("year", "y", year, "The year of Advent of Code to show")
+	statusCmd.Flags().StringP("workdir", "w", "", "Your Advent of Code working directory (defaults to the current directory)")
+	statusCmd.Flags().StringP("cookie", "c", "", "Your session cookie for adventofcode.com")
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/frederik-suerig/advent-of-code/internal/submit"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var submitCmd = &cobra.Command{
+	Use:   "submit [shorthand...]",
+	Short: "Submit an answer for a day",
+	Long: `Submit an answer to adventofcode.com and report whether it was correct.
+
+The puzzle can be selected with flags or with shorthands like y24d14p2 or d14 p2.
+The answer is taken from --answer or, if omitted, read from stdin, e.g.:
+  go run main.go submit d5 p1 < answer.txt
+
+Every verdict is recorded in the day's answers.json. Answers that were already
+rejected, or that lie outside the bounds of answers that were too low or too
+high, are refused without contacting adventofcode.com.`,
+	Args: cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		t, err := parseTarget(args)
+		if err != nil {
+			return err
+		}
+		t = t.orFlags()
+
+		answer := viper.GetString("answer")
+		if answer == "" {
+			piped, err := readPipedAnswer()
+			if err != nil {
+				return err
+			}
+			answer = piped
+		}
+
+		workDir, err := workDirOrCwd()
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		cfg := submit.Config{
+			Year:     t.year,
+			Day:      t.day,
+			Part:     t.part,
+			Answer:   answer,
+			WorkDir:  workDir,
+			Client:   client,
+			Reporter: reporter,
+		}
+
+		s, err := submit.NewSubmitter(cfg)
+		if err != nil {
+			return invalidArgs(formatError(err))
+		}
+
+		resp, err := s.Run()
+		if err != nil {
+			return formatError(err)
+		}
+
+		reporter.Success("That's the right answer!")
+		reporter.DimText("  %s", resp.Message)
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(submitCmd)
+
+	year, day := defaultYearDay()
+
+	submitCmd.Flags().IntP("day", "d", day, "The day to submit the answer for")
+	submitCmd.Flags().IntP("year", "y", year, "The year of Advent of Code you are working on")
+	submitCmd.Flags().IntP("part", "p", 1, "The part of the puzzle (1 or 2)")
+	submitCmd.Flags().StringP("answer", "a", "", "The answer to submit (read from stdin if omitted)")
+
+	submitCmd.Flags().StringP("cookie", "c", "", "Your session cookie for adventofcode.com")
+	submitCmd.Flags().StringP("workdir", "w", "", "Your Advent of Code working directory (defaults to the current directory)")
+}
+
+// readPipedAnswer reads the answer from stdin if it is piped in.
+// Only the last non-empty line is used, so solutions may print debug output before the answer.
+func readPipedAnswer() (string, error) {
+	info, err := os.Stdin.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice != 0 {
+		return "", submit.ErrAnswerRequired
+	}
+
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("failed to read answer from stdin: %w", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	return strings.TrimSpace(lines[len(lines)-1]), nil
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+var (
+	errInvalidTarget = errors.New("invalid puzzle shorthand")
+	errInvalidDays   = errors.New("invalid days")
+)
+
+// targetRegex matches the shorthands y24d14p2, y2024d14, d14p2, d14, p1 and any
+// of their parts on their own, e.g. "y24 d14 p2".
+var targetRegex = regexp.MustCompile(`^(?:y(\d{2}|\d{4}))?(?:d(\d{1,2}))?(?:p([12]))?$`)
+
+// target is a puzzle selected with shorthand arguments.
+// Zero values mean the value was not given.
+type target struct {
+	year int
+	day  int
+	part int
+}
+
+// parseTarget parses shorthand arguments like "y24d14p2" or "d14 p2".
+// Each of year, day and part may only be given once.
+func parseTarget(args []string) (target, error) {
+	var t target
+	for _, arg := range args {
+		match := targetRegex.FindStringSubmatch(strings.ToLower(arg))
+		if arg == "" || match == nil {
+			return target{}, fmt.Errorf("%w: %q (expected e.g. y24d14p2, d14 p2 or p1)", errInvalidTarget, arg)
+		}
+
+		if match[1] != "" {
+			year, _ := strconv.Atoi(match[1])
+			// If 2 digits, prepend 20; if 4 digits, use as-is
+			if len(match[1]) == 2 {
+				year += 2000
+			}
+			if err := setOnce(&t.year, year, "year"); err != nil {
+				return target{}, err
+			}
+		}
+		if match[2] != "" {
+			day, _ := strconv.Atoi(match[2])
+			if err := setOnce(&t.day, day, "day"); err != nil {
+				return target{}, err
+			}
+		}
+		if match[3] != "" {
+			part, _ := strconv.Atoi(match[3])
+			if err := setOnce(&t.part, part, "part"); err != nil {
+				return target{}, err
+			}
+		}
+	}
+	return t, nil
+}
+
+// parseYearTarget parses shorthand arguments of commands that work on a whole year, so
+// only "y24" or "y2024" is accepted.
+func parseYearTarget(args []string) (target, error) {
+	t, err := parseTarget(args)
+	if err != nil {
+		return target{}, err
+	}
+	if t.day != 0 || t.part != 0 {
+		return target{}, fmt.Errorf("%w: %q (expected a year like y24, days and parts are not supported)", errInvalidTarget, strings.Join(args, " "))
+	}
+	return t, nil
+}
+
+func setOnce(field *int, value int, name string) error {
+	if *field != 0 {
+		return fmt.Errorf("%w: %s given more than once", errInvalidTarget, name)
+	}
+	*field = value
+	return nil
+}
+
+// orFlags fills in the values not given as shorthand from the --year, --day and --part flags.
+func (t target) orFlags() target {
+	if t.year == 0 {
+		t.year = viper.GetInt("year")
+	}
+	if t.day == 0 {
+		t.day = viper.GetInt("day")
+	}
+	if t.part == 0 {
+		t.part = viper.GetInt("part")
+	}
+	return t
+}
+
+// parseDays parses a list of days and ranges like "1-5,8,10-12". The days are
+// returned in the given order, each only once. Whether a day exists in a year
+// is left to the commands.
+func parseDays(list string) ([]int, error) {
+	var days []int
+	seen := make(map[int]bool)
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		first, last, isRange := strings.Cut(item, "-")
+
+		from, err := strconv.Atoi(first)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q (expected e.g. 1-25 or 1,3,5-7)", errInvalidDays, item)
+		}
+		to := from
+		if isRange {
+			if to, err = strconv.Atoi(last); err != nil || to < from {
+				return nil, fmt.Errorf("%w: %q (expected e.g. 1-25 or 1,3,5-7)", errInvalidDays, item)
+			}
+		}
+
+		for day := from; day <= to; day++ {
+			if !seen[day] {
+				seen[day] = true
+				days = append(days, day)
+			}
+		}
+	}
+	return days, nil
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseTarget(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected target
+	}{
+		{"Compact", []string{"y24d14p2"}, target{year: 2024, day: 14, part: 2}},
+		{"Compact long year", []string{"y2024d14"}, target{year: 2024, day: 14}},
+		{"Day and part", []string{"d14p2"}, target{day: 14, part: 2}},
+		{"Part only", []string{"p1"}, target{part: 1}},
+		{"Space-separated", []string{"y24", "d17", "p2"}, target{year: 2024, day: 17, part: 2}},
+		{"Mixed", []string{"y2023", "d5p1"}, target{year: 2023, day: 5, part: 1}},
+		{"Upper case", []string{"Y24D3"}, target{year: 2024, day: 3}},
+		{"Nothing", nil, target{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseTarget(tt.args)
+			if err != nil {
+				t.Fatalf("parseTarget() error = %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("parseTarget() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseTarget_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"Part 3", []string{"p3"}},
+		{"Three digit year", []string{"y202d1"}},
+		{"Wrong order", []string{"d14y24"}},
+		{"Day twice", []string{"d14", "d15"}},
+		{"Empty", []string{""}},
+		{"Garbage", []string{"today"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseTarget(tt.args); !errors.Is(err, errInvalidTarget) {
+				t.Errorf("parseTarget() error = %v, want %v", err, errInvalidTarget)
+			}
+		})
+	}
+}
+
+func TestParseYearTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    target
+		wantErr bool
+	}{
+		{"Year", []string{"y24"}, target{year: 2024}, false},
+		{"Nothing", nil, target{}, false},
+		{"Day", []string{"y24d14"}, target{}, true},
+		{"Part", []string{"y24", "p1"}, target{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseYearTarget(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseYearTarget(%v) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			}
+			if err != nil && exitCode(err) != exitInvalidArgs {
+				t.Errorf("exitCode(%v) = %d, want %d", err, exitCode(err), exitInvalidArgs)
+			}
+			if got != tt.want {
+				t.Errorf("parseYearTarget(%v) = %+v, want %+v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDays(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     string
+		expected []int
+	}{
+		{"Single day", "5", []int{5}},
+		{"Range", "1-3", []int{1, 2, 3}},
+		{"List", "1,3,5-7", []int{1, 3, 5, 6, 7}},
+		{"Spaces", "1, 2", []int{1, 2}},
+		{"Duplicates", "1-3,2", []int{1, 2, 3}},
+		{"Order kept", "10,2", []int{10, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDays(tt.list)
+			if err != nil {
+				t.Fatalf("parseDays() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("parseDays() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseDays_Invalid(t *testing.T) {
+	tests := []string{"", "a", "1-", "-3", "5-1", "1,,2", "1-2-3"}
+
+	for _, list := range tests {
+		t.Run(list, func(t *testing.T) {
+			if _, err := parseDays(list); !errors.Is(err, errInvalidDays) {
+				t.Errorf("parseDays(%q) error = %v, want %v", list, err, errInvalidDays)
+			}
+		})
+	}
+}
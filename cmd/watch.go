@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"os"
+	"os/signal"
+
+	"github.com/frederik-suerig/advent-of-code/internal/watch"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch [shorthand...]",
+	Short: "Re-run a day every time one of its files is saved",
+	Long: `Watch the directory of a day and, on every save, run the examples of the puzzle
+description and then the real input, e.g.:
+  watch y24d05p1, watch d05 (both parts)
+
+The screen is cleared before every run. Compile errors are shown without the
+go test noise. Press Ctrl+C to stop.`,
+	Args: cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reporter := reporterOf(cmd)
+		t, err := parseTarget(args)
+		if err != nil {
+			return err
+		}
+		t = t.orFlags()
+
+		workDir, err := workDirOrCwd()
+		if err != nil {
+			return err
+		}
+
+		w, err := watch.NewWatcher(watch.Config{
+			Year:     t.year,
+			Day:      t.day,
+			Part:     t.part,
+			WorkDir:  workDir,
+			Debounce: viper.GetDuration("debounce"),
+			Stdout:   solutionOutput(),
+			Reporter: reporter,
+		})
+		if err != nil {
+			return invalidArgs(formatError(err))
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+
+		return w.Run(ctx)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	year, day := defaultYearDay()
+
+	watchCmd.Flags().IntP("day", "d", day, "The day to watch")
+	watchCmd.Flags().IntP("year", "y", year, "The year of Advent of Code you are working on")
+	watchCmd.Flags().IntP("part", "p", 0, "The part to run (1 or 2), both if omitted")
+
+	watchCmd.Flags().Duration("debounce", watch.DefaultDebounce, "How long to wait for further changes before re-running")
+	watchCmd.Flags().StringP("workdir", "w", "", "Your Advent of Code working directory (defaults to the current directory)")
+}
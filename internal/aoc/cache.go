@@ -0,0 +1,180 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Cached files of a day
+const (
+	InputFile  = "input.txt"
+	PuzzleFile = "puzzle.html"
+)
+
+// DefaultCacheDir returns the cache directory used if none is configured, e.g. ~/.cache/aoc.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find cache directory: %w", err)
+	}
+	return filepath.Join(dir, "aoc"), nil
+}
+
+// Cache stores downloaded files under {dir}/{year}/{day}. Files that belong to
+// a whole year, like leaderboards, are stored as day 0.
+type Cache struct {
+	dir string
+}
+
+// CacheEntry describes a cached file.
+type CacheEntry struct {
+	Year     int
+	Day      int
+	Name     string
+	Size     int64
+	Modified time.Time
+}
+
+// NewCache creates a cache in the given directory.
+func NewCache(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+// Dir returns the directory of the cache.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+func (c *Cache) path(year, day int, name string) string {
+	return filepath.Join(c.dir, strconv.Itoa(year), strconv.Itoa(day), name)
+}
+
+// Get returns a cached file and true if it exists.
+func (c *Cache) Get(year, day int, name string) ([]byte, bool) {
+	data, err := os.ReadFile(c.path(year, day, name))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Modified returns when a cached file was stored and true if it exists.
+func (c *Cache) Modified(year, day int, name string) (time.Time, bool) {
+	info, err := os.Stat(c.path(year, day, name))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return info.ModTime(), true
+}
+
+// Put stores a file in the cache, replacing an existing one.
+func (c *Cache) Put(year, day int, name string, data []byte) error {
+	path := c.path(year, day, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	// Inputs are personal, so keep them private
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write cache: %w", err)
+	}
+	return nil
+}
+
+// List returns all cached files of a year, or of all years if year is 0,
+// sorted by year, day and name.
+func (c *Cache) List(year int) ([]CacheEntry, error) {
+	var entries []CacheEntry
+
+	years, err := os.ReadDir(c.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache: %w", err)
+	}
+
+	for _, y := range years {
+		entryYear, err := strconv.Atoi(y.Name())
+		if err != nil || !y.IsDir() || (year != 0 && entryYear != year) {
+			continue
+		}
+
+		days, err := os.ReadDir(filepath.Join(c.dir, y.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cache: %w", err)
+		}
+		for _, d := range days {
+			entryDay, err := strconv.Atoi(d.Name())
+			if err != nil || !d.IsDir() {
+				continue
+			}
+
+			files, err := os.ReadDir(filepath.Join(c.dir, y.Name(), d.Name()))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read cache: %w", err)
+			}
+			for _, f := range files {
+				info, err := f.Info()
+				if err != nil || f.IsDir() {
+					continue
+				}
+				entries = append(entries, CacheEntry{
+					Year:     entryYear,
+					Day:      entryDay,
+					Name:     f.Name(),
+					Size:     info.Size(),
+					Modified: info.ModTime(),
+				})
+			}
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Year != entries[j].Year {
+			return entries[i].Year < entries[j].Year
+		}
+		if entries[i].Day != entries[j].Day {
+			return entries[i].Day < entries[j].Day
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, nil
+}
+
+// Clear removes the cached files of a day. A day of 0 clears the whole year,
+// and a year of 0 clears all years.
+func (c *Cache) Clear(year, day int) error {
+	var paths []string
+	switch {
+	case year == 0:
+		// Keep the rate limit state, only remove the cached years
+		years, err := os.ReadDir(c.dir)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read cache: %w", err)
+		}
+		for _, y := range years {
+			if _, err := strconv.Atoi(y.Name()); err == nil && y.IsDir() {
+				paths = append(paths, filepath.Join(c.dir, y.Name()))
+			}
+		}
+	case day == 0:
+		paths = append(paths, filepath.Join(c.dir, strconv.Itoa(year)))
+	default:
+		paths = append(paths, filepath.Join(c.dir, strconv.Itoa(year), strconv.Itoa(day)))
+	}
+
+	for _, path := range paths {
+		if err := os.RemoveAll(path); err != nil {
+			return fmt.Errorf("failed to clear cache: %w", err)
+		}
+	}
+	return nil
+}
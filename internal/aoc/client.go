@@ -0,0 +1,269 @@
+package aoc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the Advent of Code website.
+	DefaultBaseURL = "https://adventofcode.com"
+	// DefaultUserAgent identifies this tool, as requested by the Advent of Code maintainers.
+	DefaultUserAgent = "github.com/frederik-suerig/advent-of-code"
+	// DefaultMinInterval is the minimum time between two requests.
+	DefaultMinInterval = 3 * time.Second
+	// LeaderboardTTL is how long a private leaderboard is reused. The API asks
+	// not to be polled more often than once every 15 minutes.
+	LeaderboardTTL = 15 * time.Minute
+)
+
+// Config holds the configuration of a Client
+type Config struct {
+	// BaseURL overrides DefaultBaseURL, e.g. to talk to a test server.
+	BaseURL string
+	Cookie  string
+	// UserAgent overrides DefaultUserAgent. Please include a way to contact you.
+	UserAgent string
+	// MinInterval overrides DefaultMinInterval. A negative value disables rate limiting.
+	MinInterval time.Duration
+	// CacheDir overrides DefaultCacheDir.
+	CacheDir string
+	// HTTPClient overrides http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// Client talks to adventofcode.com politely: it identifies itself, keeps a
+// minimum interval between requests and caches inputs and puzzle pages.
+type Client struct {
+	baseURL   string
+	cookie    string
+	userAgent string
+
+	http    *http.Client
+	limiter *limiter
+	cache   *Cache
+}
+
+func NewClient(cfg Config) (*Client, error) {
+	c := &Client{
+		baseURL:   strings.TrimSuffix(cfg.BaseURL, "/"),
+		cookie:    cfg.Cookie,
+		userAgent: cfg.UserAgent,
+		http:      cfg.HTTPClient,
+	}
+	if c.baseURL == "" {
+		c.baseURL = DefaultBaseURL
+	}
+	if c.userAgent == "" {
+		c.userAgent = DefaultUserAgent
+	}
+	if c.http == nil {
+		c.http = http.DefaultClient
+	}
+
+	cacheDir := cfg.CacheDir
+	if cacheDir == "" {
+		dir, err := DefaultCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		cacheDir = dir
+	}
+	c.cache = NewCache(cacheDir)
+
+	interval := cfg.MinInterval
+	if interval == 0 {
+		interval = DefaultMinInterval
+	}
+	c.limiter = newLimiter(cacheDir, interval)
+
+	return c, nil
+}
+
+// HasCookie returns true if the client sends a session cookie.
+func (c *Client) HasCookie() bool {
+	return c.cookie != ""
+}
+
+// BaseURL returns the website the client talks to.
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// Cache returns the cache used by the client.
+func (c *Client) Cache() *Cache {
+	return c.cache
+}
+
+// PuzzleURL returns the URL of the puzzle page of a day.
+func (c *Client) PuzzleURL(year, day int) string {
+	return fmt.Sprintf("%s/%d/day/%d", c.baseURL, year, day)
+}
+
+// Input returns the puzzle input of a day, downloading it only if it isn't cached.
+// Downloads that fail ValidateInput are returned as a RequestError and not cached.
+func (c *Client) Input(year, day int) ([]byte, error) {
+	if !c.HasCookie() {
+		return nil, ErrCookieRequired
+	}
+
+	// A cached input that doesn't pass ValidateInput was stored by an older version, so it is downloaded again
+	if data, ok := c.cache.Get(year, day, InputFile); ok && ValidateInput(data) == nil {
+		return data, nil
+	}
+
+	body, err := c.Get(fmt.Sprintf("/%d/day/%d/input", year, day))
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateInput(body); err != nil {
+		return nil, NewRequestError(err.Error(), 0)
+	}
+
+	if err := c.cache.Put(year, day, InputFile, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// Puzzle returns the puzzle page of a day. A cached page is used unless refresh is set,
+// which is needed to see part two after solving part one.
+func (c *Client) Puzzle(year, day int, refresh bool) ([]byte, error) {
+	if !refresh {
+		if data, ok := c.cache.Get(year, day, PuzzleFile); ok {
+			return data, nil
+		}
+	}
+
+	body, err := c.Get(fmt.Sprintf("/%d/day/%d", year, day))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.cache.Put(year, day, PuzzleFile, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// Event returns the event page of a year, whose calendar shows the stars of the
+// logged in user. It is never cached, as the stars change while solving.
+func (c *Client) Event(year int) ([]byte, error) {
+	return c.Get(fmt.Sprintf("/%d", year))
+}
+
+// Leaderboard returns the JSON of a private leaderboard and when it was downloaded.
+// A download younger than LeaderboardTTL is reused.
+func (c *Client) Leaderboard(year, id int) ([]byte, time.Time, error) {
+	if !c.HasCookie() {
+		return nil, time.Time{}, ErrCookieRequired
+	}
+
+	name := fmt.Sprintf("leaderboard-%d.json", id)
+	if modified, ok := c.cache.Modified(year, 0, name); ok && time.Since(modified) < LeaderboardTTL {
+		if data, ok := c.cache.Get(year, 0, name); ok {
+			return data, modified, nil
+		}
+	}
+
+	body, err := c.Get(fmt.Sprintf("/%d/leaderboard/private/view/%d.json", year, id))
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if err := c.cache.Put(year, 0, name, body); err != nil {
+		return nil, time.Time{}, err
+	}
+	return body, time.Now(), nil
+}
+
+// Submit posts an answer and returns the HTML of the reply. Replies are never cached.
+func (c *Client) Submit(year, day, part int, answer string) ([]byte, error) {
+	if !c.HasCookie() {
+		return nil, ErrCookieRequired
+	}
+
+	form := url.Values{
+		"level":  {fmt.Sprintf("%d", part)},
+		"answer": {answer},
+	}
+	return c.do("POST", fmt.Sprintf("/%d/day/%d/answer", year, day), form)
+}
+
+// CheckSession verifies that the session cookie is accepted by fetching the settings page,
+// which only shows a logout link to users that are logged in.
+func (c *Client) CheckSession() error {
+	if !c.HasCookie() {
+		return ErrCookieRequired
+	}
+
+	body, err := c.Get("/settings")
+	if err != nil {
+		return err
+	}
+	if !bytes.Contains(body, []byte("/auth/logout")) {
+		return ErrSessionInvalid
+	}
+	return nil
+}
+
+// Get downloads a page of the website without caching it.
+func (c *Client) Get(path string) ([]byte, error) {
+	return c.do("GET", path, nil)
+}
+
+func (c *Client) do(method, path string, form url.Values) ([]byte, error) {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, body)
+	if err != nil {
+		return nil, NewRequestError(fmt.Sprintf("failed to create request: %v", err), 0)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if c.cookie != "" {
+		req.AddCookie(&http.Cookie{Name: "session", Value: c.cookie})
+	}
+
+	if err := c.limiter.wait(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, NewRequestError(fmt.Sprintf("network error: %v", err), 0)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		// Provide more specific error messages based on status code
+		switch resp.StatusCode {
+		case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden:
+			return nil, NewRequestError("authentication failed - check your session cookie", resp.StatusCode)
+		case http.StatusNotFound:
+			return nil, NewRequestError("puzzle not available - it may not be released yet", resp.StatusCode)
+		default:
+			return nil, NewRequestError(resp.Status, resp.StatusCode)
+		}
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); closeErr != nil {
+		return nil, fmt.Errorf("failed to close response body: %w", closeErr)
+	}
+	if err != nil {
+		return nil, NewRequestError(fmt.Sprintf("failed to read response: %v", err), 0)
+	}
+
+	return data, nil
+}
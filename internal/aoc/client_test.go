@@ -0,0 +1,298 @@
+package aoc
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClient_Input(t *testing.T) {
+	requests := 0
+	var userAgent, cookie string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		userAgent = r.UserAgent()
+		if c, err := r.Cookie("session"); err == nil {
+			cookie = c.Value
+		}
+		if r.URL.Path != "/2024/day/5/input" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("1 2 3\n"))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(Config{
+		BaseURL:     server.URL,
+		Cookie:      "secret",
+		UserAgent:   "test agent",
+		CacheDir:    t.TempDir(),
+		MinInterval: -1,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		input, err := c.Input(2024, 5)
+		if err != nil {
+			t.Fatalf("Input() error = %v", err)
+		}
+		if string(input) != "1 2 3\n" {
+			t.Errorf("Input() = %q, want %q", input, "1 2 3\n")
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("server received %d requests, want 1", requests)
+	}
+	if userAgent != "test agent" || cookie != "secret" {
+		t.Errorf("user agent, cookie = %q, %q, want %q, %q", userAgent, cookie, "test agent", "secret")
+	}
+
+	_, err = c.Input(2024, 6)
+	var requestErr *RequestError
+	if !errors.As(err, &requestErr) || requestErr.Status != http.StatusNotFound {
+		t.Errorf("Input() error = %v, want RequestError with status 404", err)
+	}
+}
+
+func TestClient_InputInvalid(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte("Puzzle inputs differ by user.  Please log in to get your puzzle input.\n"))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(Config{BaseURL: server.URL, Cookie: "secret", CacheDir: t.TempDir(), MinInterval: -1})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := c.Input(2024, 5)
+		var requestErr *RequestError
+		if !errors.As(err, &requestErr) || requestErr.Reason != ErrInputLoggedOut.Error() {
+			t.Errorf("Input() error = %v, want RequestError %q", err, ErrInputLoggedOut)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("server received %d requests, want 2 as invalid inputs are not cached", requests)
+	}
+}
+
+func TestClient_Puzzle(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte("<article></article>"))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(Config{BaseURL: server.URL, CacheDir: t.TempDir(), MinInterval: -1})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	for _, refresh := range []bool{false, false, true} {
+		if _, err := c.Puzzle(2024, 5, refresh); err != nil {
+			t.Fatalf("Puzzle() error = %v", err)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("server received %d requests, want 2", requests)
+	}
+
+	if _, err := c.Input(2024, 5); !errors.Is(err, ErrCookieRequired) {
+		t.Errorf("Input() error = %v, want %v", err, ErrCookieRequired)
+	}
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	now := time.Date(2024, 12, 1, 5, 0, 0, 0, time.UTC)
+	var slept []time.Duration
+
+	l := newLimiter(t.TempDir(), 3*time.Second)
+	l.now = func() time.Time { return now }
+	l.sleep = func(d time.Duration) {
+		slept = append(slept, d)
+		now = now.Add(d)
+	}
+
+	if err := l.wait(); err != nil {
+		t.Fatalf("wait() error = %v", err)
+	}
+	now = now.Add(time.Second)
+	if err := l.wait(); err != nil {
+		t.Fatalf("wait() error = %v", err)
+	}
+	now = now.Add(5 * time.Second)
+	if err := l.wait(); err != nil {
+		t.Fatalf("wait() error = %v", err)
+	}
+
+	if len(slept) != 1 || slept[0] != 2*time.Second {
+		t.Errorf("slept %v, want [2s]", slept)
+	}
+}
+
+func TestLimiter_Lock(t *testing.T) {
+	tests := []struct {
+		name string
+		// age is how long ago the other process took the lock
+		age       time.Duration
+		wantSlept []time.Duration
+	}{
+		{"Held by another process", time.Second, []time.Duration{lockRetry}},
+		{"Stale", 2 * staleLock, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newLimiter(t.TempDir(), 3*time.Second)
+			path := l.state + ".lock"
+			if err := os.WriteFile(path, nil, 0644); err != nil {
+				t.Fatal(err)
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			now := info.ModTime().Add(tt.age)
+			var slept []time.Duration
+			l.now = func() time.Time { return now }
+			// The other process releases the lock while we sleep
+			l.sleep = func(d time.Duration) {
+				slept = append(slept, d)
+				_ = os.Remove(path)
+			}
+
+			unlock, err := l.lock()
+			if err != nil {
+				t.Fatalf("lock() error = %v", err)
+			}
+			unlock()
+
+			if !reflect.DeepEqual(slept, tt.wantSlept) {
+				t.Errorf("slept %v, want %v", slept, tt.wantSlept)
+			}
+		})
+	}
+}
+
+func TestCache_ListClear(t *testing.T) {
+	c := NewCache(t.TempDir())
+	for _, day := range []int{1, 2} {
+		if err := c.Put(2023, day, InputFile, []byte("x")); err != nil {
+			t.Fatalf("Put() error = %v", err)
+		}
+	}
+	if err := c.Put(2024, 1, PuzzleFile, []byte("y")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	entries, err := c.List(0)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("List() returned %d entries, want 3", len(entries))
+	}
+	if entries[0].Year != 2023 || entries[0].Day != 1 || entries[0].Name != InputFile {
+		t.Errorf("List()[0] = %+v, want 2023 day 1 %s", entries[0], InputFile)
+	}
+
+	if err := c.Clear(2023, 1); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if entries, _ := c.List(2023); len(entries) != 1 {
+		t.Errorf("List(2023) after clearing a day returned %d entries, want 1", len(entries))
+	}
+
+	if err := c.Clear(0, 0); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if entries, _ := c.List(0); len(entries) != 0 {
+		t.Errorf("List() after clearing everything returned %d entries, want 0", len(entries))
+	}
+}
+
+func TestClient_CheckSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c, err := r.Cookie("session"); err == nil && c.Value == "valid" {
+			_, _ = w.Write([]byte(`<a href="/auth/logout">[Log Out]</a>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<a href="/auth/login">[Log In]</a>`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		cookie  string
+		wantErr error
+	}{
+		{name: "valid cookie", cookie: "valid"},
+		{name: "expired cookie", cookie: "expired", wantErr: ErrSessionInvalid},
+		{name: "no cookie", wantErr: ErrCookieRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClient(Config{BaseURL: server.URL, Cookie: tt.cookie, CacheDir: t.TempDir(), MinInterval: -1})
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			if err := c.CheckSession(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckSession() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_Leaderboard(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/2024/leaderboard/private/view/42.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"event":"2024"}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(Config{BaseURL: server.URL, Cookie: "secret", CacheDir: t.TempDir(), MinInterval: -1})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := c.Leaderboard(2024, 42); err != nil {
+			t.Fatalf("Leaderboard() error = %v", err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("server received %d requests within the TTL, want 1", requests)
+	}
+
+	// Expire the cached leaderboard
+	expired := time.Now().Add(-LeaderboardTTL - time.Minute)
+	path := c.Cache().path(2024, 0, "leaderboard-42.json")
+	if err := os.Chtimes(path, expired, expired); err != nil {
+		t.Fatal(err)
+	}
+	if _, fetched, err := c.Leaderboard(2024, 42); err != nil || time.Since(fetched) > time.Minute {
+		t.Errorf("Leaderboard() = fetched %v, %v, want a fresh download", fetched, err)
+	}
+	if requests != 2 {
+		t.Errorf("server received %d requests after the TTL, want 2", requests)
+	}
+}
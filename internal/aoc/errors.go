@@ -0,0 +1,37 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Domain-specific errors
+var (
+	ErrCookieRequired = errors.New("cookie is required")
+	ErrSessionInvalid = errors.New("session cookie is invalid or expired")
+
+	// Inputs rejected by ValidateInput
+	ErrInputEmpty     = errors.New("input is empty")
+	ErrInputHTML      = errors.New("input is an HTML page - check your session cookie")
+	ErrInputLoggedOut = errors.New("input asks to log in - check your session cookie")
+	ErrInputThrottled = errors.New("input was requested too often - wait a while before downloading it again")
+	ErrInputTruncated = errors.New("input does not end with a newline - the download was probably cut off")
+)
+
+// RequestError represents a failed request to adventofcode.com
+type RequestError struct {
+	Reason string
+	Status int
+}
+
+func (e *RequestError) Error() string {
+	if e.Status > 0 {
+		return fmt.Sprintf("request failed: %s (status %d)", e.Reason, e.Status)
+	}
+	return fmt.Sprintf("request failed: %s", e.Reason)
+}
+
+// NewRequestError creates a new RequestError
+func NewRequestError(reason string, status int) *RequestError {
+	return &RequestError{Reason: reason, Status: status}
+}
@@ -0,0 +1,29 @@
+package aoc
+
+import "bytes"
+
+// ValidateInput checks that a downloaded input looks like a puzzle input and not like
+// one of the messages adventofcode.com sends instead, which also come with status 200.
+func ValidateInput(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return ErrInputEmpty
+	}
+
+	if bytes.Contains(trimmed, []byte("Please don't repeatedly request")) {
+		return ErrInputThrottled
+	}
+	if bytes.Contains(trimmed, []byte("Please log in")) {
+		return ErrInputLoggedOut
+	}
+
+	prefix := bytes.ToLower(trimmed[:min(len(trimmed), 64)])
+	if bytes.HasPrefix(prefix, []byte("<!doctype html")) || bytes.HasPrefix(prefix, []byte("<html")) {
+		return ErrInputHTML
+	}
+
+	if data[len(data)-1] != '\n' {
+		return ErrInputTruncated
+	}
+	return nil
+}
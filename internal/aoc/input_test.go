@@ -0,0 +1,32 @@
+package aoc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{name: "puzzle input", data: "1 2 3\n4 5 6\n"},
+		{name: "single line", data: "abc\n"},
+		{name: "empty", data: "", wantErr: ErrInputEmpty},
+		{name: "only whitespace", data: " \n\n", wantErr: ErrInputEmpty},
+		{name: "throttled", data: "Please don't repeatedly request this endpoint before it unlocks! The calendar countdown is synchronized with the server time; the link will be enabled on the calendar the instant this puzzle becomes available.\n", wantErr: ErrInputThrottled},
+		{name: "logged out", data: "Puzzle inputs differ by user.  Please log in to get your puzzle input.\n", wantErr: ErrInputLoggedOut},
+		{name: "html page", data: "<!DOCTYPE html>\n<html lang=\"en-us\">\n</html>\n", wantErr: ErrInputHTML},
+		{name: "html without doctype", data: "<html><body></body></html>\n", wantErr: ErrInputHTML},
+		{name: "missing trailing newline", data: "1 2 3\n4 5", wantErr: ErrInputTruncated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateInput([]byte(tt.data)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateInput() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
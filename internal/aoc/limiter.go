@@ -0,0 +1,104 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// staleLock is how old a lock file may get before it is considered abandoned.
+	staleLock = time.Minute
+	// lockRetry is how long to wait before trying again to acquire a lock held by another process.
+	lockRetry = 50 * time.Millisecond
+)
+
+// limiter enforces a minimum interval between requests. The time of the last
+// request is stored in a state file, so the interval also holds across
+// invocations and concurrently running commands.
+type limiter struct {
+	interval time.Duration
+	state    string
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func newLimiter(dir string, interval time.Duration) *limiter {
+	return &limiter{
+		interval: interval,
+		state:    filepath.Join(dir, "last-request"),
+		now:      time.Now,
+		sleep:    time.Sleep,
+	}
+}
+
+// wait blocks until the next request may be sent and records it as sent.
+func (l *limiter) wait() error {
+	if l.interval <= 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.state), 0755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+
+	unlock, err := l.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if last, ok := l.last(); ok {
+		if remaining := l.interval - l.now().Sub(last); remaining > 0 {
+			l.sleep(remaining)
+		}
+	}
+
+	stamp := strconv.FormatInt(l.now().UnixNano(), 10)
+	if err := os.WriteFile(l.state, []byte(stamp), 0644); err != nil {
+		return fmt.Errorf("failed to write rate limit state: %w", err)
+	}
+	return nil
+}
+
+// last returns the time of the last request, and false if none was recorded.
+func (l *limiter) last() (time.Time, bool) {
+	data, err := os.ReadFile(l.state)
+	if err != nil {
+		return time.Time{}, false
+	}
+	nanos, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, nanos), true
+}
+
+// lock acquires the lock file next to the state file and returns a function releasing it.
+func (l *limiter) lock() (func(), error) {
+	path := l.state + ".lock"
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			_ = f.Close()
+			return func() {
+				_ = os.Remove(path)
+			}, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to acquire rate limit lock: %w", err)
+		}
+
+		// Remove locks left behind by a crashed process
+		if info, err := os.Stat(path); err == nil && l.now().Sub(info.ModTime()) > staleLock {
+			_ = os.Remove(path)
+			continue
+		}
+		l.sleep(lockRetry)
+	}
+}
@@ -0,0 +1,20 @@
+package aoc
+
+import "time"
+
+// eastern is the time zone puzzles are released in. December has no daylight saving time.
+var eastern = time.FixedZone("EST", -5*60*60)
+
+// DaysInYear returns the number of puzzles of a year.
+func DaysInYear(year int) int {
+	// From 2025 onwards, there are only 12 challenges per year.
+	if year >= 2025 {
+		return 12
+	}
+	return 25
+}
+
+// UnlockTime returns when the puzzle of a day is released: midnight US Eastern time.
+func UnlockTime(year, day int) time.Time {
+	return time.Date(year, time.December, day, 0, 0, 0, 0, eastern)
+}
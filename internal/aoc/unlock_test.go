@@ -0,0 +1,44 @@
+package aoc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUnlockTime(t *testing.T) {
+	tests := []struct {
+		name string
+		year int
+		day  int
+		want time.Time
+	}{
+		{name: "first day", year: 2024, day: 1, want: time.Date(2024, time.December, 1, 5, 0, 0, 0, time.UTC)},
+		{name: "last day", year: 2025, day: 12, want: time.Date(2025, time.December, 12, 5, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnlockTime(tt.year, tt.day); !got.Equal(tt.want) {
+				t.Errorf("UnlockTime() = %v, want %v", got.UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDaysInYear(t *testing.T) {
+	tests := []struct {
+		year int
+		want int
+	}{
+		{2015, 25},
+		{2024, 25},
+		{2025, 12},
+		{2026, 12},
+	}
+
+	for _, tt := range tests {
+		if got := DaysInYear(tt.year); got != tt.want {
+			t.Errorf("DaysInYear(%d) = %d, want %d", tt.year, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,363 @@
+package bench
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/gomod"
+)
+
+//go:embed templates/bench_test.go.tmpl
+var benchTemplate string
+
+const (
+	// DefaultCount is how often each part is run.
+	DefaultCount = 10
+	// DefaultBenchTime runs a part once per sample, so each sample is a single solve.
+	DefaultBenchTime = "1x"
+)
+
+var (
+	dayDirRegex = regexp.MustCompile(`y(\d{4})[\\/]d(\d{2})$`)
+	// resultRegex matches a line of `go test -bench -benchmem` output, e.g.
+	// "BenchmarkY2024D05P1-8   	       1	   1234567 ns/op	  2048 B/op	  12 allocs/op"
+	resultRegex = regexp.MustCompile(`^BenchmarkY(\d{4})D(\d{2})P([12])(?:-\d+)?\s+\d+\s+([\d.]+) ns/op(?:\s+([\d.]+) B/op\s+([\d.]+) allocs/op)?`)
+	partFuncs   = map[int]string{1: "PartOne", 2: "PartTwo"}
+)
+
+// Config holds the configuration for benchmarking Advent of Code solutions
+type Config struct {
+	Year int
+	// Day is the day to benchmark, or 0 to benchmark all days of the year.
+	Day int
+	// Part is the part to benchmark, or 0 to benchmark both parts.
+	Part    int
+	WorkDir string
+	// Count is how often each part is run. Defaults to DefaultCount.
+	Count int
+	// BenchTime is passed to go test -benchtime. Defaults to DefaultBenchTime.
+	BenchTime string
+}
+
+// Result holds the timings of a single part
+type Result struct {
+	Year   int           `json:"year"`
+	Day    int           `json:"day"`
+	Part   int           `json:"part"`
+	Runs   int           `json:"runs"`
+	Min    time.Duration `json:"min"`
+	Median time.Duration `json:"median"`
+	P95    time.Duration `json:"p95"`
+	// BytesPerOp and AllocsPerOp are the medians over all runs.
+	BytesPerOp  int64 `json:"bytesPerOp"`
+	AllocsPerOp int64 `json:"allocsPerOp"`
+}
+
+// Name returns a short name of the part, e.g. "y2024 d05 p2".
+func (r Result) Name() string {
+	return fmt.Sprintf("y%04d d%02d p%d", r.Year, r.Day, r.Part)
+}
+
+type Benchmarker struct {
+	year int
+	day  int
+	part int
+
+	count     int
+	benchTime string
+
+	workDir string
+}
+
+func NewBenchmarker(cfg Config) (*Benchmarker, error) {
+	b := &Benchmarker{
+		year:      cfg.Year,
+		day:       cfg.Day,
+		part:      cfg.Part,
+		count:     cfg.Count,
+		benchTime: cfg.BenchTime,
+		workDir:   cfg.WorkDir,
+	}
+	if b.count == 0 {
+		b.count = DefaultCount
+	}
+	if b.benchTime == "" {
+		b.benchTime = DefaultBenchTime
+	}
+	if err := b.init(); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (b *Benchmarker) init() error {
+	if b.day < 0 || b.day > 25 {
+		return fmt.Errorf("%w: %d", ErrInvalidDay, b.day)
+	}
+	if b.year <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidYear, b.year)
+	}
+
+	if b.day > aoc.DaysInYear(b.year) {
+		return fmt.Errorf("%w: %d for year %d", ErrInvalidDay, b.day, b.year)
+	}
+
+	if b.part < 0 || b.part > 2 {
+		return fmt.Errorf("%w: %d", ErrInvalidPart, b.part)
+	}
+	if b.count < 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidCount, b.count)
+	}
+
+	if b.workDir == "" {
+		return ErrWorkdirRequired
+	}
+
+	return nil
+}
+
+// Parts returns the parts that will be benchmarked.
+func (b *Benchmarker) Parts() []int {
+	if b.part == 0 {
+		return []int{1, 2}
+	}
+	return []int{b.part}
+}
+
+// Run benchmarks the selected days that have an input with go test -bench and
+// returns the results sorted by day and part.
+func (b *Benchmarker) Run() ([]Result, error) {
+	days, err := b.days()
+	if err != nil {
+		return nil, err
+	}
+	if len(days) == 0 {
+		return nil, ErrNoDays
+	}
+
+	module, err := gomod.ModulePath(b.workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// The benchmarks must live inside the module to import the solutions.
+	// Directories starting with "." are ignored by ./... patterns.
+	tmpDir, err := os.MkdirTemp(b.workDir, ".aoc-bench-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+
+	if err := b.writeBenchmarks(tmpDir, module, days); err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command("go", "test",
+		"-run", "^$",
+		"-bench", ".",
+		"-benchmem",
+		"-count", strconv.Itoa(b.count),
+		"-benchtime", b.benchTime,
+	)
+	cmd.Dir = tmpDir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, NewBenchmarkError(string(output))
+	}
+
+	return parseResults(output), nil
+}
+
+type day struct {
+	Year int
+	Day  int
+	Dir  string
+}
+
+// days returns the selected days that have a solution and an input.
+func (b *Benchmarker) days() ([]day, error) {
+	pattern := filepath.Join(b.workDir, fmt.Sprintf("y%04d", b.year), "d[0-9][0-9]")
+	if b.day > 0 {
+		pattern = filepath.Join(b.workDir, fmt.Sprintf("y%04d", b.year), fmt.Sprintf("d%02d", b.day))
+	}
+
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find days: %w", err)
+	}
+
+	var days []day
+	for _, dir := range matches {
+		m := dayDirRegex.FindStringSubmatch(dir)
+		if m == nil {
+			continue
+		}
+		if !fileExists(filepath.Join(dir, "solution.go")) || !fileExists(filepath.Join(dir, "testdata", "input.txt")) {
+			continue
+		}
+		year, _ := strconv.Atoi(m[1])
+		d, _ := strconv.Atoi(m[2])
+		days = append(days, day{Year: year, Day: d, Dir: dir})
+	}
+	return days, nil
+}
+
+type templateDay struct {
+	Alias      string
+	ImportPath string
+}
+
+type templateBenchmark struct {
+	Name      string
+	Alias     string
+	Func      string
+	InputPath string
+}
+
+type templateData struct {
+	Days       []templateDay
+	Benchmarks []templateBenchmark
+}
+
+func (b *Benchmarker) writeBenchmarks(dir, module string, days []day) error {
+	tmpl, err := template.New("bench").Parse(benchTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var data templateData
+	for _, d := range days {
+		rel, err := filepath.Rel(b.workDir, d.Dir)
+		if err != nil {
+			return fmt.Errorf("failed to resolve package path: %w", err)
+		}
+		alias := fmt.Sprintf("y%04dd%02d", d.Year, d.Day)
+		data.Days = append(data.Days, templateDay{Alias: alias, ImportPath: module + "/" + filepath.ToSlash(rel)})
+
+		for _, part := range b.Parts() {
+			data.Benchmarks = append(data.Benchmarks, templateBenchmark{
+				Name:      fmt.Sprintf("Y%04dD%02dP%d", d.Year, d.Day, part),
+				Alias:     alias,
+				Func:      partFuncs[part],
+				InputPath: filepath.Join(d.Dir, "testdata", "input.txt"),
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "bench_test.go"), buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write benchmarks: %w", err)
+	}
+	return nil
+}
+
+type sample struct {
+	nsPerOp     float64
+	bytesPerOp  float64
+	allocsPerOp float64
+}
+
+// parseResults aggregates the samples of each benchmark in go test output.
+func parseResults(output []byte) []Result {
+	type key struct{ year, day, part int }
+	samples := make(map[key][]sample)
+	var order []key
+
+	for _, line := range bytes.Split(output, []byte("\n")) {
+		m := resultRegex.FindSubmatch(bytes.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		year, _ := strconv.Atoi(string(m[1]))
+		d, _ := strconv.Atoi(string(m[2]))
+		part, _ := strconv.Atoi(string(m[3]))
+		k := key{year, d, part}
+
+		var s sample
+		s.nsPerOp, _ = strconv.ParseFloat(string(m[4]), 64)
+		if len(m[5]) > 0 {
+			s.bytesPerOp, _ = strconv.ParseFloat(string(m[5]), 64)
+			s.allocsPerOp, _ = strconv.ParseFloat(string(m[6]), 64)
+		}
+
+		if _, ok := samples[k]; !ok {
+			order = append(order, k)
+		}
+		samples[k] = append(samples[k], s)
+	}
+
+	results := make([]Result, 0, len(order))
+	for _, k := range order {
+		results = append(results, summarize(k.year, k.day, k.part, samples[k]))
+	}
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		return a.Part < b.Part
+	})
+	return results
+}
+
+func summarize(year, day, part int, samples []sample) Result {
+	times := make([]float64, len(samples))
+	bytesPerOp := make([]float64, len(samples))
+	allocs := make([]float64, len(samples))
+	for i, s := range samples {
+		times[i] = s.nsPerOp
+		bytesPerOp[i] = s.bytesPerOp
+		allocs[i] = s.allocsPerOp
+	}
+
+	return Result{
+		Year:        year,
+		Day:         day,
+		Part:        part,
+		Runs:        len(samples),
+		Min:         time.Duration(percentile(times, 0)),
+		Median:      time.Duration(percentile(times, 50)),
+		P95:         time.Duration(percentile(times, 95)),
+		BytesPerOp:  int64(percentile(bytesPerOp, 50)),
+		AllocsPerOp: int64(percentile(allocs, 50)),
+	}
+}
+
+// percentile returns the nearest-rank percentile p of values.
+func percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	rank := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	rank = max(0, min(rank, len(sorted)-1))
+	return sorted[rank]
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
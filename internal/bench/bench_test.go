@@ -0,0 +1,83 @@
+package bench
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseResults(t *testing.T) {
+	output := []byte(`goos: linux
+goarch: amd64
+pkg: example.com/aoc/.aoc-bench-123
+BenchmarkY2024D05P2-8   	       1	      3000 ns/op	     512 B/op	       4 allocs/op
+BenchmarkY2024D05P1-8   	       1	      1000 ns/op	     100 B/op	       1 allocs/op
+BenchmarkY2024D05P1-8   	       1	      3000 ns/op	     300 B/op	       3 allocs/op
+BenchmarkY2024D05P1-8   	       1	      2000 ns/op	     200 B/op	       2 allocs/op
+BenchmarkY2023D10P1     	       1	       500 ns/op
+PASS
+ok  	example.com/aoc/.aoc-bench-123	0.012s
+`)
+
+	want := []Result{
+		{Year: 2023, Day: 10, Part: 1, Runs: 1, Min: 500, Median: 500, P95: 500},
+		{Year: 2024, Day: 5, Part: 1, Runs: 3, Min: 1000, Median: 2000, P95: 3000, BytesPerOp: 200, AllocsPerOp: 2},
+		{Year: 2024, Day: 5, Part: 2, Runs: 1, Min: 3000, Median: 3000, P95: 3000, BytesPerOp: 512, AllocsPerOp: 4},
+	}
+
+	got := parseResults(output)
+	if len(got) != len(want) {
+		t.Fatalf("parseResults() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("parseResults()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{9, 1, 8, 2, 7, 3, 6, 4, 5, 10}
+
+	tests := []struct {
+		name string
+		p    float64
+		want float64
+	}{
+		{name: "min", p: 0, want: 1},
+		{name: "median", p: 50, want: 5},
+		{name: "p95", p: 95, want: 10},
+		{name: "max", p: 100, want: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := percentile(values, tt.p); got != tt.want {
+				t.Errorf("percentile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewBenchmarker(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr error
+	}{
+		{name: "whole year", cfg: Config{Year: 2024, WorkDir: "."}},
+		{name: "single part", cfg: Config{Year: 2024, Day: 5, Part: 2, WorkDir: "."}},
+		{name: "day out of range", cfg: Config{Year: 2025, Day: 13, WorkDir: "."}, wantErr: ErrInvalidDay},
+		{name: "invalid part", cfg: Config{Year: 2024, Part: 3, WorkDir: "."}, wantErr: ErrInvalidPart},
+		{name: "negative count", cfg: Config{Year: 2024, Count: -1, WorkDir: "."}, wantErr: ErrInvalidCount},
+		{name: "no workdir", cfg: Config{Year: 2024}, wantErr: ErrWorkdirRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBenchmarker(tt.cfg)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewBenchmarker() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
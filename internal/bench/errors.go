@@ -0,0 +1,35 @@
+package bench
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Domain-specific errors
+var (
+	ErrInvalidDay      = errors.New("invalid day")
+	ErrInvalidYear     = errors.New("invalid year")
+	ErrInvalidPart     = errors.New("invalid part")
+	ErrInvalidCount    = errors.New("count must be positive")
+	ErrWorkdirRequired = errors.New("workdir is required")
+	ErrNoDays          = errors.New("no days with an input to benchmark")
+)
+
+// BenchmarkError represents benchmarks that could not be compiled or failed
+type BenchmarkError struct {
+	Output string
+}
+
+func (e *BenchmarkError) Error() string {
+	output := strings.TrimSpace(e.Output)
+	if output == "" {
+		return "failed to run benchmarks"
+	}
+	return fmt.Sprintf("failed to run benchmarks:\n%s", output)
+}
+
+// NewBenchmarkError creates a new BenchmarkError
+func NewBenchmarkError(output string) *BenchmarkError {
+	return &BenchmarkError{Output: output}
+}
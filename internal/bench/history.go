@@ -0,0 +1,198 @@
+package bench
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultRegressionFactor is how much slower a part must get to count as a regression.
+const DefaultRegressionFactor = 1.5
+
+// DefaultHistoryFile is where results are recorded, relative to the working directory.
+var DefaultHistoryFile = filepath.Join(".aoc", "bench-history.json")
+
+var csvHeader = []string{"time", "year", "day", "part", "runs", "min_ns", "median_ns", "p95_ns", "bytes_per_op", "allocs_per_op"}
+
+// Entry is a result recorded in the history
+type Entry struct {
+	Time time.Time `json:"time"`
+	Result
+}
+
+// History holds all recorded results. It is stored as JSON, or as CSV if the
+// file name ends with .csv.
+type History struct {
+	path    string
+	Entries []Entry
+}
+
+// Regression is a part that got slower since it was last benchmarked
+type Regression struct {
+	Current  Result `json:"current"`
+	Previous Entry  `json:"previous"`
+	// Factor is how many times slower the median got.
+	Factor float64 `json:"factor"`
+}
+
+func (r Regression) String() string {
+	return fmt.Sprintf("%s got %.1fx slower since last run (%s → %s)",
+		r.Current.Name(), r.Factor, r.Previous.Median, r.Current.Median)
+}
+
+// LoadHistory reads the history at path. A missing file is an empty history.
+func LoadHistory(path string) (*History, error) {
+	h := &History{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	if h.isCSV() {
+		h.Entries, err = parseCSV(data)
+	} else if len(strings.TrimSpace(string(data))) > 0 {
+		err = json.Unmarshal(data, &h.Entries)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse history %s: %w", path, err)
+	}
+	return h, nil
+}
+
+// Last returns the latest entry of a part.
+func (h *History) Last(year, day, part int) (Entry, bool) {
+	for i := len(h.Entries) - 1; i >= 0; i-- {
+		e := h.Entries[i]
+		if e.Year == year && e.Day == day && e.Part == part {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Regressions compares results to the latest entry of each part and returns
+// the parts whose median got at least factor times slower.
+func (h *History) Regressions(results []Result, factor float64) []Regression {
+	var regressions []Regression
+	for _, res := range results {
+		prev, ok := h.Last(res.Year, res.Day, res.Part)
+		if !ok || prev.Median <= 0 {
+			continue
+		}
+		if f := float64(res.Median) / float64(prev.Median); f >= factor {
+			regressions = append(regressions, Regression{Current: res, Previous: prev, Factor: f})
+		}
+	}
+	return regressions
+}
+
+// Append records results and writes the history.
+func (h *History) Append(at time.Time, results []Result) error {
+	for _, res := range results {
+		h.Entries = append(h.Entries, Entry{Time: at, Result: res})
+	}
+
+	var data []byte
+	var err error
+	if h.isCSV() {
+		data, err = formatCSV(h.Entries)
+	} else {
+		data, err = json.MarshalIndent(h.Entries, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encode history: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(h.path), 0755); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+	if err := os.WriteFile(h.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+	return nil
+}
+
+func (h *History) isCSV() bool {
+	return strings.EqualFold(filepath.Ext(h.path), ".csv")
+}
+
+func formatCSV(entries []Entry) ([]byte, error) {
+	var sb strings.Builder
+	w := csv.NewWriter(&sb)
+	if err := w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		record := []string{
+			e.Time.Format(time.RFC3339),
+			strconv.Itoa(e.Year),
+			strconv.Itoa(e.Day),
+			strconv.Itoa(e.Part),
+			strconv.Itoa(e.Runs),
+			strconv.FormatInt(int64(e.Min), 10),
+			strconv.FormatInt(int64(e.Median), 10),
+			strconv.FormatInt(int64(e.P95), 10),
+			strconv.FormatInt(e.BytesPerOp, 10),
+			strconv.FormatInt(e.AllocsPerOp, 10),
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return []byte(sb.String()), w.Error()
+}
+
+func parseCSV(data []byte) ([]Entry, error) {
+	records, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for i, record := range records {
+		if i == 0 && len(record) > 0 && record[0] == csvHeader[0] {
+			continue
+		}
+		if len(record) != len(csvHeader) {
+			return nil, fmt.Errorf("line %d: expected %d fields, got %d", i+1, len(csvHeader), len(record))
+		}
+
+		at, err := time.Parse(time.RFC3339, record[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		numbers := make([]int64, len(record)-1)
+		for j, field := range record[1:] {
+			if numbers[j], err = strconv.ParseInt(field, 10, 64); err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+		}
+
+		entries = append(entries, Entry{
+			Time: at,
+			Result: Result{
+				Year:        int(numbers[0]),
+				Day:         int(numbers[1]),
+				Part:        int(numbers[2]),
+				Runs:        int(numbers[3]),
+				Min:         time.Duration(numbers[4]),
+				Median:      time.Duration(numbers[5]),
+				P95:         time.Duration(numbers[6]),
+				BytesPerOp:  numbers[7],
+				AllocsPerOp: numbers[8],
+			},
+		})
+	}
+	return entries, nil
+}
@@ -0,0 +1,59 @@
+package bench
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestHistory(t *testing.T) {
+	first := []Result{
+		{Year: 2024, Day: 5, Part: 1, Runs: 10, Min: time.Millisecond, Median: 2 * time.Millisecond, P95: 3 * time.Millisecond, BytesPerOp: 1024, AllocsPerOp: 8},
+		{Year: 2024, Day: 5, Part: 2, Runs: 10, Min: time.Millisecond, Median: time.Millisecond, P95: time.Millisecond},
+	}
+	second := []Result{
+		{Year: 2024, Day: 5, Part: 1, Runs: 10, Min: time.Millisecond, Median: 2 * time.Millisecond, P95: 3 * time.Millisecond},
+		{Year: 2024, Day: 5, Part: 2, Runs: 10, Min: 2 * time.Millisecond, Median: 3 * time.Millisecond, P95: 4 * time.Millisecond},
+		{Year: 2024, Day: 6, Part: 1, Runs: 10, Min: time.Second, Median: time.Second, P95: time.Second},
+	}
+	at := time.Date(2024, time.December, 5, 6, 0, 0, 0, time.UTC)
+
+	for _, name := range []string{"history.json", "history.csv"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ".aoc", name)
+
+			h, err := LoadHistory(path)
+			if err != nil {
+				t.Fatalf("LoadHistory() error = %v", err)
+			}
+			if regressions := h.Regressions(first, DefaultRegressionFactor); len(regressions) != 0 {
+				t.Errorf("Regressions() without history = %v, want none", regressions)
+			}
+			if err := h.Append(at, first); err != nil {
+				t.Fatalf("Append() error = %v", err)
+			}
+
+			h, err = LoadHistory(path)
+			if err != nil {
+				t.Fatalf("LoadHistory() error = %v", err)
+			}
+			if len(h.Entries) != len(first) {
+				t.Fatalf("LoadHistory() has %d entries, want %d", len(h.Entries), len(first))
+			}
+			if got := h.Entries[0]; !got.Time.Equal(at) || got.Result != first[0] {
+				t.Errorf("LoadHistory() entry = %+v, want %+v at %v", got, first[0], at)
+			}
+
+			regressions := h.Regressions(second, DefaultRegressionFactor)
+			if len(regressions) != 1 {
+				t.Fatalf("Regressions() = %v, want one regression", regressions)
+			}
+			if r := regressions[0]; r.Current.Part != 2 || r.Factor != 3 {
+				t.Errorf("Regressions() = %+v, want part 2 three times slower", r)
+			}
+			if got, want := regressions[0].String(), "y2024 d05 p2 got 3.0x slower since last run (1ms → 3ms)"; got != want {
+				t.Errorf("Regression.String() = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Package config reads and writes the CLI's config file and session cookie.
+//
+// The config file is named aoc.toml (or aoc.yaml, aoc.json) and is searched for in the
+// current directory first and in the user's config directory (~/.config/aoc) second.
+// Its keys match the flag names, so a flag that is not set falls back to the config file.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// Name is the name of the config file without extension.
+	Name = "aoc"
+	// DefaultType is the format of config files written by Init.
+	DefaultType = "toml"
+)
+
+// Key is a setting that can be stored in the config file.
+type Key struct {
+	Name  string
+	Usage string
+	parse func(string) (any, error)
+}
+
+// Keys lists all settings of the config file.
+var Keys = []Key{
+	{Name: "workdir", Usage: "Your Advent of Code working directory", parse: parseString},
+	{Name: "year", Usage: "The year used when neither --year nor a shorthand is given", parse: parseYear},
+	{Name: "template-dir", Usage: "A directory with templates replacing the built-in ones", parse: parseString},
+	{Name: "user-agent", Usage: "The User-Agent sent to adventofcode.com", parse: parseString},
+	{Name: "leaderboard", Usage: "The private leaderboard shown when no ID is given", parse: parseID},
+}
+
+// Lookup returns the key with the given name.
+func Lookup(name string) (Key, error) {
+	for _, key := range Keys {
+		if key.Name == name {
+			return key, nil
+		}
+	}
+	return Key{}, fmt.Errorf("%w: %s", ErrUnknownKey, name)
+}
+
+// Parse converts a value given on the command line to the type stored in the config file.
+func (k Key) Parse(value string) (any, error) {
+	return k.parse(value)
+}
+
+func parseString(value string) (any, error) {
+	return value, nil
+}
+
+func parseYear(value string) (any, error) {
+	year, err := strconv.Atoi(value)
+	if err != nil || year <= 0 {
+		return nil, fmt.Errorf("%w: year must be a positive number, got %q", ErrInvalidValue, value)
+	}
+	return year, nil
+}
+
+func parseID(value string) (any, error) {
+	id, err := strconv.Atoi(value)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("%w: leaderboard must be a positive number, got %q", ErrInvalidValue, value)
+	}
+	return id, nil
+}
+
+// Dir returns the user's config directory for this tool, e.g. ~/.config/aoc.
+func Dir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find config directory: %w", err)
+	}
+	return filepath.Join(dir, Name), nil
+}
+
+// DefaultPath returns where Init writes the config file if no path is given.
+func DefaultPath() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, Name+"."+DefaultType), nil
+}
+
+// Load reads the config file into v. If file is empty, the search paths are used
+// and a missing config file is not an error.
+func Load(v *viper.Viper, file string) error {
+	if file != "" {
+		v.SetConfigFile(file)
+	} else {
+		v.SetConfigName(Name)
+		v.AddConfigPath(".")
+		if dir, err := Dir(); err == nil {
+			v.AddConfigPath(dir)
+		}
+	}
+
+	if err := v.ReadInConfig(); err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if file == "" && errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	return nil
+}
+
+// Init writes a new config file with the given values.
+// An existing file is only replaced if overwrite is set.
+func Init(path string, values map[string]any, overwrite bool) error {
+	if _, err := os.Stat(path); err == nil && !overwrite {
+		return fmt.Errorf("%w: %s", ErrConfigExists, path)
+	}
+
+	v := viper.New()
+	for name, value := range values {
+		if _, err := Lookup(name); err != nil {
+			return err
+		}
+		v.Set(name, value)
+	}
+	return write(v, path)
+}
+
+// Set changes a single key of an existing config file.
+func Set(path, name, value string) error {
+	key, err := Lookup(name)
+	if err != nil {
+		return err
+	}
+	parsed, err := key.Parse(value)
+	if err != nil {
+		return err
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	v.Set(name, parsed)
+	return write(v, path)
+}
+
+func write(v *viper.Viper, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := v.WriteConfigAs(path); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
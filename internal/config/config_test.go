@@ -0,0 +1,123 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestInitAndSet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "aoc.toml")
+
+	values := map[string]any{"workdir": "/tmp/aoc", "year": 2023}
+	if err := Init(path, values, false); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	if err := Init(path, values, false); !errors.Is(err, ErrConfigExists) {
+		t.Errorf("Init() again error = %v, want %v", err, ErrConfigExists)
+	}
+
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		wantErr error
+	}{
+		{name: "year", key: "year", value: "2022"},
+		{name: "string", key: "user-agent", value: "me@example.com"},
+		{name: "invalid year", key: "year", value: "last", wantErr: ErrInvalidValue},
+		{name: "unknown key", key: "colour", value: "red", wantErr: ErrUnknownKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Set(path, tt.key, tt.value); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Set() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	v := viper.New()
+	if err := Load(v, path); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := v.GetInt("year"); got != 2022 {
+		t.Errorf("year = %d, want 2022", got)
+	}
+	if got := v.GetString("workdir"); got != "/tmp/aoc" {
+		t.Errorf("workdir = %q, want %q", got, "/tmp/aoc")
+	}
+	if got := v.GetString("user-agent"); got != "me@example.com" {
+		t.Errorf("user-agent = %q, want %q", got, "me@example.com")
+	}
+}
+
+func TestLoad_SearchPaths(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Chdir(t.TempDir())
+
+	v := viper.New()
+	if err := Load(v, ""); err != nil {
+		t.Fatalf("Load() without config file error = %v", err)
+	}
+
+	userPath, err := DefaultPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Init(userPath, map[string]any{"year": 2021}, false); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	if err := os.WriteFile("aoc.yaml", []byte("year: 2020\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The current directory takes precedence over the user's config directory
+	v = viper.New()
+	if err := Load(v, ""); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := v.GetInt("year"); got != 2020 {
+		t.Errorf("year = %d, want 2020", got)
+	}
+
+	if err := Load(viper.New(), "missing.toml"); err == nil {
+		t.Error("Load() with missing explicit file error = nil, want error")
+	}
+}
+
+func TestSession(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cookie, err := LoadSession()
+	if err != nil || cookie != "" {
+		t.Fatalf("LoadSession() = %q, %v, want empty", cookie, err)
+	}
+
+	if err := SaveSession("  "); !errors.Is(err, ErrCookieRequired) {
+		t.Errorf("SaveSession() error = %v, want %v", err, ErrCookieRequired)
+	}
+	if err := SaveSession("secret\n"); err != nil {
+		t.Fatalf("SaveSession() error = %v", err)
+	}
+
+	cookie, err = LoadSession()
+	if err != nil || cookie != "secret" {
+		t.Errorf("LoadSession() = %q, %v, want %q", cookie, err, "secret")
+	}
+
+	path, err := SessionPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("session file permissions = %o, want 600", perm)
+	}
+}
@@ -0,0 +1,12 @@
+package config
+
+import "errors"
+
+// Domain-specific errors
+var (
+	ErrUnknownKey     = errors.New("unknown config key")
+	ErrInvalidValue   = errors.New("invalid config value")
+	ErrConfigExists   = errors.New("config file already exists")
+	ErrNoConfigFile   = errors.New("no config file found - run 'aoc config init' first")
+	ErrCookieRequired = errors.New("cookie is required")
+)
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SessionFile is the name of the file in Dir holding the session cookie.
+const SessionFile = "session"
+
+// SessionPath returns the path of the file holding the session cookie.
+func SessionPath() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, SessionFile), nil
+}
+
+// LoadSession returns the stored session cookie, or an empty string if there is none.
+func LoadSession() (string, error) {
+	path, err := SessionPath()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read session file: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// SaveSession stores the session cookie in a file only the current user can read.
+func SaveSession(cookie string) error {
+	cookie = strings.TrimSpace(cookie)
+	if cookie == "" {
+		return ErrCookieRequired
+	}
+
+	path, err := SessionPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, []byte(cookie+"\n"), 0o600); err != nil {
+		return fmt.Errorf("failed to write session file: %w", err)
+	}
+	// WriteFile keeps the mode of an existing file
+	if err := os.Chmod(path, 0o600); err != nil {
+		return fmt.Errorf("failed to restrict session file permissions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package create
+
+import (
+	"errors"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+)
+
+// Outcome is what happened to a day created by RunDays
+type Outcome int
+
+const (
+	Created Outcome = iota
+	Skipped
+	Failed
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Created:
+		return "created"
+	case Skipped:
+		return "skipped"
+	default:
+		return "failed"
+	}
+}
+
+// DayResult is the outcome of a single day created by RunDays
+type DayResult struct {
+	Day     int
+	Outcome Outcome
+	// Reason explains a skipped or failed day, or why a created day has no input.
+	Reason string
+	// Err is the error of a failed day.
+	Err error
+}
+
+// RunDays creates several days of cfg.Year with the same configuration, one after the other
+// with one client, so downloads are rate limited as usual. All days are validated before the
+// first one is created. Days that already exist are skipped unless cfg.Force is set, and days
+// that are not released yet are created without downloads. A failing day doesn't stop the
+// others, it is reported in the results.
+func RunDays(cfg Config, days []int) ([]DayResult, error) {
+	generators := make([]*Generator, 0, len(days))
+	for _, day := range days {
+		dayCfg := cfg
+		dayCfg.Day = day
+		// Nobody wants to answer a prompt per day, and waiting only makes sense for a single day
+		dayCfg.NoInput = true
+		dayCfg.Wait = false
+
+		g, err := NewGenerator(dayCfg)
+		if err != nil {
+			return nil, err
+		}
+		generators = append(generators, g)
+	}
+
+	results := make([]DayResult, 0, len(generators))
+	for _, g := range generators {
+		res := DayResult{Day: g.day}
+		if !g.skipDownloads && aoc.UnlockTime(g.year, g.day).After(g.now()) {
+			g.skipDownloads = true
+			res.Reason = "not released yet, nothing downloaded"
+		}
+
+		err := g.Run()
+		switch {
+		case err == nil:
+			res.Outcome = Created
+			if res.Reason == "" && !g.skipDownloads && !g.client.HasCookie() {
+				res.Reason = "no cookie, input not downloaded"
+			}
+		case errors.Is(err, ErrDayExists):
+			res.Outcome = Skipped
+			res.Reason = "already exists"
+		default:
+			res.Outcome = Failed
+			res.Reason = errorReason(err)
+			res.Err = err
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
@@ -0,0 +1,106 @@
+package create
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+)
+
+func TestRunDays(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("..", "puzzle", "testdata", "day.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/2024/day/3"):
+			w.WriteHeader(http.StatusInternalServerError)
+		case strings.HasSuffix(r.URL.Path, "/input"):
+			_, _ = w.Write([]byte("1\n2\n"))
+		default:
+			_, _ = w.Write(page)
+		}
+	}))
+	defer server.Close()
+
+	client, err := aoc.NewClient(aoc.Config{BaseURL: server.URL, Cookie: "session", CacheDir: t.TempDir(), MinInterval: -1})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	workDir := t.TempDir()
+	existing := filepath.Join(workDir, "y2024", "d01", "solution.go")
+	if err := os.MkdirAll(filepath.Dir(existing), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(existing, []byte("package d01\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Day 4 unlocks an hour from now
+	now := aoc.UnlockTime(2024, 4).Add(-time.Hour)
+	cfg := Config{Year: 2024, WorkDir: workDir, Client: client, Now: func() time.Time { return now }, Reporter: ui.NewQuiet(io.Discard, io.Discard)}
+
+	results, err := RunDays(cfg, []int{1, 2, 3, 4})
+	if err != nil {
+		t.Fatalf("RunDays() error = %v", err)
+	}
+
+	var outcomes []Outcome
+	for _, res := range results {
+		outcomes = append(outcomes, res.Outcome)
+	}
+	if want := []Outcome{Skipped, Created, Failed, Created}; !reflect.DeepEqual(outcomes, want) {
+		t.Errorf("RunDays() outcomes = %v, want %v", outcomes, want)
+	}
+
+	var downloadErr *DownloadError
+	if !errors.As(results[2].Err, &downloadErr) || downloadErr.Status != http.StatusInternalServerError {
+		t.Errorf("RunDays() day 3 error = %v, want a DownloadError with status 500", results[2].Err)
+	}
+
+	if data, err := os.ReadFile(existing); err != nil || string(data) != "package d01\n" {
+		t.Errorf("existing day 1 was changed")
+	}
+	if _, err := os.Stat(filepath.Join(workDir, "y2024", "d02", "testdata", "input.txt")); err != nil {
+		t.Errorf("input of day 2 was not downloaded: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(workDir, "y2024", "d04", "solution.go")); err != nil {
+		t.Errorf("unreleased day 4 was not created: %v", err)
+	}
+	for _, path := range requests {
+		if strings.HasPrefix(path, "/2024/day/4") {
+			t.Errorf("RunDays() requested %s before day 4 unlocked", path)
+		}
+	}
+}
+
+func TestRunDays_Invalid(t *testing.T) {
+	client, err := aoc.NewClient(aoc.Config{CacheDir: t.TempDir(), MinInterval: -1})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	workDir := t.TempDir()
+
+	// From 2025 onwards there are only 12 days, so nothing may be created
+	_, err = RunDays(Config{Year: 2025, WorkDir: workDir, Client: client, Reporter: ui.NewQuiet(io.Discard, io.Discard)}, []int{12, 13})
+	if !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("RunDays() error = %v, want %v", err, ErrInvalidDay)
+	}
+	if _, err := os.Stat(filepath.Join(workDir, "y2025")); !os.IsNotExist(err) {
+		t.Errorf("RunDays() created days despite an invalid one")
+	}
+}
@@ -1,22 +1,17 @@
 package create
 
 import (
-	_ "embed"
+	"bytes"
+	"errors"
 	"fmt"
-	"html/template"
-	"io"
-	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/puzzle"
 	"github.com/frederik-suerig/advent-of-code/internal/ui"
-)
-
-var (
-	//go:embed templates/solution.go.tmpl
-	solutionTemplate string
-	//go:embed templates/solution_test.go.tmpl
-	solutionTestTemplate string
+	"github.com/spf13/afero"
 )
 
 // Config holds the configuration for creating a new Advent of Code challenge
@@ -24,24 +19,90 @@ type Config struct {
 	Year    int
 	Day     int
 	WorkDir string
-	Cookie  string
+	// TemplateDir replaces the built-in templates. Its whole tree is rendered into the day.
+	TemplateDir string
+	// Client downloads inputs and puzzles. Its session cookie is needed for the input.
+	// Tests point it to a test server with the BaseURL and HTTPClient of aoc.Config.
+	Client *aoc.Client
+	// Fs is the filesystem the day is created in, the real one if nil.
+	Fs afero.Fs
+	// Heuristics tune how examples are extracted from the puzzle description.
+	Heuristics puzzle.Heuristics
+	// Force deletes and recreates a day that already exists without asking.
+	Force bool
+	// NoInput never asks whether to recreate an existing day; Run fails with ErrDayExists instead.
+	NoInput bool
+	// SkipDownloads only renders the templates, without the puzzle description and input,
+	// e.g. for a day that is not released yet.
+	SkipDownloads bool
+	// Wait waits until the puzzle unlocks and retries downloads that fail because it isn't out yet.
+	Wait bool
+	// Now and Sleep replace time.Now and time.Sleep while waiting, e.g. in tests.
+	Now   func() time.Time
+	Sleep func(time.Duration)
+	// Reporter shows the progress.
+	Reporter ui.Reporter
+	// Confirm asks whether an existing day may be deleted and recreated, ui.ConfirmOverwrite if nil.
+	Confirm func(year, day int, relPath string) (bool, error)
 }
 
 type Generator struct {
 	day    int
 	year   int
-	cookie string
+	client *aoc.Client
+
+	heuristics puzzle.Heuristics
+
+	force         bool
+	noInput       bool
+	skipDownloads bool
 
-	workDir   string
-	outputDir string
+	wait  bool
+	now   func() time.Time
+	sleep func(time.Duration)
+
+	reporter ui.Reporter
+	confirm  func(year, day int, relPath string) (bool, error)
+	fs       afero.Fs
+
+	workDir     string
+	templateDir string
+	outputDir   string
 }
 
 func NewGenerator(cfg Config) (*Generator, error) {
 	g := &Generator{
-		day:     cfg.Day,
-		year:    cfg.Year,
-		workDir: cfg.WorkDir,
-		cookie:  cfg.Cookie,
+		day:         cfg.Day,
+		year:        cfg.Year,
+		workDir:     cfg.WorkDir,
+		templateDir: cfg.TemplateDir,
+		client:      cfg.Client,
+
+		heuristics: cfg.Heuristics,
+
+		force:         cfg.Force,
+		noInput:       cfg.NoInput,
+		skipDownloads: cfg.SkipDownloads,
+
+		wait:  cfg.Wait,
+		now:   cfg.Now,
+		sleep: cfg.Sleep,
+
+		reporter: cfg.Reporter,
+		confirm:  cfg.Confirm,
+		fs:       cfg.Fs,
+	}
+	if g.now == nil {
+		g.now = time.Now
+	}
+	if g.sleep == nil {
+		g.sleep = time.Sleep
+	}
+	if g.confirm == nil {
+		g.confirm = ui.ConfirmOverwrite
+	}
+	if g.fs == nil {
+		g.fs = afero.NewOsFs()
 	}
 	if err := g.init(); err != nil {
 		return nil, err
@@ -58,8 +119,7 @@ func (g *Generator) init() error {
 		return fmt.Errorf("%w: %d", ErrInvalidYear, g.year)
 	}
 
-	// From 2025 onwards, there are only 12 challenges per year.
-	if g.year >= 2025 && g.day > 12 {
+	if g.day > aoc.DaysInYear(g.year) {
 		return fmt.Errorf("%w: %d for year %d", ErrInvalidDay, g.day, g.year)
 	}
 
@@ -67,6 +127,20 @@ func (g *Generator) init() error {
 		return ErrWorkdirRequired
 	}
 
+	if g.client == nil {
+		return ErrClientRequired
+	}
+
+	if g.reporter == nil {
+		return ErrReporterRequired
+	}
+
+	if g.templateDir != "" {
+		if info, err := g.fs.Stat(g.templateDir); err != nil || !info.IsDir() {
+			return fmt.Errorf("%w: %s", ErrTemplateDirNotFound, g.templateDir)
+		}
+	}
+
 	g.outputDir = filepath.Join(
 		g.workDir,
 		fmt.Sprintf("y%04d", g.year),
@@ -77,172 +151,229 @@ func (g *Generator) init() error {
 }
 
 func (g *Generator) Run() error {
-	ui.Header("Creating Advent of Code %d - Day %d", g.year, g.day)
+	g.reporter.Header("Creating Advent of Code %d - Day %d", g.year, g.day)
 
-	// Check if directory or files already exist
-	if g.directoryOrFilesExist() {
-		relPath := ui.MakeRelative(g.outputDir)
-		shouldDelete, err := ui.ConfirmOverwrite(g.year, g.day, relPath)
-		if err != nil {
+	// Check if directory or files already exist. This is settled before waiting for the
+	// unlock, so nobody waits for a day only to be asked about it or see it fail.
+	exists, err := g.directoryOrFilesExist()
+	if err != nil {
+		return err
+	}
+	if exists {
+		if err := g.confirmOverwrite(); err != nil {
 			return err
 		}
-		if !shouldDelete {
-			return fmt.Errorf("operation cancelled by user")
-		}
+	}
+
+	if g.wait {
+		g.waitForUnlock()
+	}
+
+	if exists {
 		if err := g.deleteDirectory(); err != nil {
 			return fmt.Errorf("failed to delete existing directory: %w", err)
 		}
+		// The registry must not refer to the deleted day in case creating it again fails.
+		if err := g.updateRegistry(); err != nil {
+			return err
+		}
 	}
 
 	if err := g.createFolderStructure(); err != nil {
 		return err
 	}
 
-	if err := g.renderTemplates(); err != nil {
-		return err
-	}
-
-	if g.cookie != "" {
-		if err := g.downloadInput(); err != nil {
+	// The puzzle is downloaded first, so its title is available to the templates
+	var page []byte
+	if !g.skipDownloads {
+		err := g.retry(func() (err error) {
+			page, err = g.fetchPuzzle(false)
 			return err
+		})
+		if err != nil {
+			// The description is nice to have, so don't fail the whole day over it
+			g.reporter.Warning("Could not download puzzle description: %s", errorReason(err))
 		}
-	} else {
-		ui.Warning("No cookie provided - skipping input download")
-		ui.DimText("  You can download the input manually or provide a cookie with --cookie")
 	}
 
-	return nil
-}
+	if err := g.renderTemplates(g.templateData(page)); err != nil {
+		return err
+	}
 
-func (g *Generator) createFolderStructure() error {
-	// Check if directory already exists
-	dirExists := false
-	if info, err := os.Stat(g.outputDir); err == nil && info.IsDir() {
-		dirExists = true
+	if err := g.updateRegistry(); err != nil {
+		return err
 	}
 
-	if err := os.MkdirAll(g.outputDir, 0755); err != nil {
-		return fmt.Errorf("failed to create directory: %w", err)
+	if page != nil {
+		if err := g.writePuzzle(page); err != nil {
+			g.reporter.Warning("Could not store puzzle description: %s", errorReason(err))
+		}
 	}
 
-	// Only show success message if we actually created the directory
-	if !dirExists {
-		ui.DirCreated(g.outputDir)
+	switch {
+	case g.skipDownloads:
+		g.reporter.Warning("Skipping the puzzle description and input downloads")
+	case g.client.HasCookie():
+		if err := g.retry(g.downloadInput); err != nil {
+			return err
+		}
+	default:
+		g.reporter.Warning("No cookie provided - skipping input download")
+		g.reporter.DimText("  You can download the input manually or provide a cookie with --cookie")
 	}
+
 	return nil
 }
 
-func (g *Generator) renderTemplates() error {
-	if err := g.renderTemplate(solutionTemplate, "solution.go"); err != nil {
-		return err
+// confirmOverwrite decides whether the existing day may be deleted, asking the user unless
+// Force or NoInput is set.
+func (g *Generator) confirmOverwrite() error {
+	relPath := ui.MakeRelative(g.outputDir)
+	if g.force {
+		g.reporter.Warning("Recreating day %d of %d at %s", g.day, g.year, relPath)
+		return nil
+	}
+	if g.noInput {
+		return fmt.Errorf("%w: %s", ErrDayExists, relPath)
 	}
 
-	if err := g.renderTemplate(solutionTestTemplate, "solution_test.go"); err != nil {
+	shouldDelete, err := g.confirm(g.year, g.day, relPath)
+	if err != nil {
 		return err
 	}
-
+	if !shouldDelete {
+		return ui.ErrCancelled
+	}
 	return nil
 }
 
-type templateData struct {
-	Day  int
-	Year int
-}
+// Refresh downloads the puzzle description of an existing day again, e.g. to add
+// part two after solving part one. Examples and their tests are only added if they
+// don't exist yet, and the solution is left untouched.
+func (g *Generator) Refresh() error {
+	g.reporter.Header("Refreshing Advent of Code %d - Day %d", g.year, g.day)
 
-func (g *Generator) renderTemplate(templateText, filename string) error {
-	path := filepath.Join(g.outputDir, filename)
-
-	if fileExists(path) {
-		return NewFileExistsError(path)
+	if info, err := g.fs.Stat(g.outputDir); err != nil || !info.IsDir() {
+		return fmt.Errorf("%w: day %d of %d", ErrDayNotCreated, g.day, g.year)
 	}
 
-	tmpl, err := template.New(filename).Parse(templateText)
-	if err != nil {
-		return fmt.Errorf("failed to parse template: %w", err)
+	if !g.client.HasCookie() {
+		g.reporter.Warning("No cookie provided - part two is only shown when logged in")
 	}
 
-	f, err := os.Create(path)
-	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
+	return g.downloadPuzzle(true)
+}
+
+func (g *Generator) createFolderStructure() error {
+	// Check if directory already exists
+	dirExists := false
+	if info, err := g.fs.Stat(g.outputDir); err == nil && info.IsDir() {
+		dirExists = true
 	}
-	defer func() {
-		if closeErr := f.Close(); closeErr != nil {
-			// Log but don't fail if close fails after successful write
-			_, _ = fmt.Fprintf(os.Stderr, "warning: failed to close file %s: %v\n", path, closeErr)
-		}
-	}()
 
-	if err := tmpl.Execute(f, templateData{
-		Day:  g.day,
-		Year: g.year,
-	}); err != nil {
-		return fmt.Errorf("failed to execute template: %w", err)
+	if err := g.fs.MkdirAll(g.outputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
 	}
 
-	ui.FileCreated(path)
+	// Only show success message if we actually created the directory
+	if !dirExists {
+		g.reporter.DirCreated(g.outputDir)
+	}
 	return nil
 }
 
 func (g *Generator) downloadInput() error {
 	path := filepath.Join(g.outputDir, "testdata", "input.txt")
-	if fileExists(path) {
+	exists, err := fileExists(g.fs, path)
+	if err != nil {
+		return err
+	}
+	if exists {
 		return NewFileExistsError(path)
 	}
 
-	if g.cookie == "" {
+	if !g.client.HasCookie() {
 		return ErrCookieRequired
 	}
 
-	url := fmt.Sprintf("https://adventofcode.com/%d/day/%d/input", g.year, g.day)
-	ui.Download("Downloading input from adventofcode.com")
-	req, err := http.NewRequest("GET", url, nil)
+	g.reporter.Download("Downloading input from adventofcode.com")
+	body, err := g.client.Input(g.year, g.day)
 	if err != nil {
-		return NewDownloadError(fmt.Sprintf("failed to create request: %v", err), 0)
+		return downloadError(err)
 	}
-	req.AddCookie(&http.Cookie{Name: "session", Value: g.cookie})
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return NewDownloadError(fmt.Sprintf("network error: %v", err), 0)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		_ = resp.Body.Close()
-		// Provide more specific error messages based on status code
-		switch resp.StatusCode {
-		case http.StatusUnauthorized, http.StatusForbidden:
-			return NewDownloadError("authentication failed - check your session cookie", resp.StatusCode)
-		case http.StatusNotFound:
-			return NewDownloadError("input not available - puzzle may not be released yet", resp.StatusCode)
-		default:
-			return NewDownloadError(resp.Status, resp.StatusCode)
-		}
+
+	if err := g.fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	if err := afero.WriteFile(g.fs, path, body, 0644); err != nil {
+		return fmt.Errorf("failed to write input file: %w", err)
 	}
 
-	body, err := io.ReadAll(resp.Body)
-	if closeErr := resp.Body.Close(); closeErr != nil {
-		return fmt.Errorf("failed to close response body: %w", closeErr)
+	g.reporter.FileCreated(path)
+	return nil
+}
+
+// downloadPuzzle downloads the puzzle page, stores its description and extracts its examples.
+// Without a cookie only part one is visible. A cached page is used unless refresh is set.
+func (g *Generator) downloadPuzzle(refresh bool) error {
+	page, err := g.fetchPuzzle(refresh)
+	if err != nil {
+		return err
 	}
+	return g.writePuzzle(page)
+}
+
+func (g *Generator) fetchPuzzle(refresh bool) ([]byte, error) {
+	g.reporter.Download("Downloading puzzle description from adventofcode.com")
+	page, err := g.client.Puzzle(g.year, g.day, refresh)
 	if err != nil {
-		return NewDownloadError(fmt.Sprintf("failed to read response: %v", err), 0)
+		return nil, downloadError(err)
 	}
+	return page, nil
+}
 
-	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
-		return fmt.Errorf("failed to create directory: %w", err)
+// writePuzzle stores the description of the puzzle page and extracts its examples.
+func (g *Generator) writePuzzle(page []byte) error {
+	if err := g.writeDescription(page, g.client.PuzzleURL(g.year, g.day)); err != nil {
+		return err
 	}
 
-	if err := os.WriteFile(path, body, 0644); err != nil {
-		return fmt.Errorf("failed to write input file: %w", err)
+	return g.writeExamples(page)
+}
+
+// writeDescription converts the puzzle page to Markdown and writes it to README.md,
+// replacing an existing one.
+func (g *Generator) writeDescription(body []byte, url string) error {
+	path := filepath.Join(g.outputDir, "README.md")
+
+	desc, err := puzzle.ParseDescription(bytes.NewReader(body), url)
+	if err != nil {
+		return fmt.Errorf("failed to parse puzzle description: %w", err)
 	}
 
-	ui.FileCreated(path)
+	if err := afero.WriteFile(g.fs, path, []byte(desc.Markdown(url)), 0644); err != nil {
+		return fmt.Errorf("failed to write puzzle description: %w", err)
+	}
+
+	g.reporter.FileCreated(path)
 	return nil
 }
 
+// downloadError converts a failed request into a DownloadError.
+func downloadError(err error) error {
+	var requestErr *aoc.RequestError
+	if errors.As(err, &requestErr) {
+		return NewDownloadError(requestErr.Reason, requestErr.Status)
+	}
+	return err
+}
+
 // directoryOrFilesExist checks if the output directory or any expected files exist
-func (g *Generator) directoryOrFilesExist() bool {
+func (g *Generator) directoryOrFilesExist() (bool, error) {
 	// Check if directory exists
-	if info, err := os.Stat(g.outputDir); err == nil && info.IsDir() {
-		return true
+	if info, err := g.fs.Stat(g.outputDir); err == nil && info.IsDir() {
+		return true, nil
 	}
 
 	// Check if any of the expected files exist
@@ -253,30 +384,43 @@ func (g *Generator) directoryOrFilesExist() bool {
 	}
 
 	for _, file := range expectedFiles {
-		if fileExists(file) {
-			return true
+		exists, err := fileExists(g.fs, file)
+		if err != nil {
+			return false, err
+		}
+		if exists {
+			return true, nil
 		}
 	}
 
-	return false
+	return false, nil
 }
 
 // deleteDirectory removes the entire output directory
 func (g *Generator) deleteDirectory() error {
-	if err := os.RemoveAll(g.outputDir); err != nil {
+	if err := g.fs.RemoveAll(g.outputDir); err != nil {
 		return fmt.Errorf("failed to remove directory: %w", err)
 	}
 	return nil
 }
 
-// fileExists checks whether filename exists.
-func fileExists(filename string) bool {
-	info, err := os.Stat(filename)
-	if os.IsNotExist(err) {
-		return false
+// fileExists checks whether filename exists in fsys and is not a directory.
+func fileExists(fsys afero.Fs, filename string) (bool, error) {
+	info, err := fsys.Stat(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
 	}
 	if err != nil {
-		panic(err)
+		return false, fmt.Errorf("failed to check %s: %w", filename, err)
+	}
+	return !info.IsDir(), nil
+}
+
+// errorReason returns the reason of a DownloadError, or the error message otherwise.
+func errorReason(err error) string {
+	var downloadErr *DownloadError
+	if errors.As(err, &downloadErr) {
+		return downloadErr.Reason
 	}
-	return !info.IsDir()
+	return err.Error()
 }
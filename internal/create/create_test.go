@@ -0,0 +1,498 @@
+package create
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+	"github.com/spf13/afero"
+)
+
+const (
+	testWorkDir = "/aoc"
+	testInput   = "47\n11\n"
+)
+
+// testDayDir is where day 5 of 2024 is created in the test filesystem.
+var testDayDir = filepath.Join(testWorkDir, "y2024", "d05")
+
+// newPuzzleServer serves the puzzle fixture and testInput, or fails every request with status.
+func newPuzzleServer(t *testing.T, status int) *httptest.Server {
+	t.Helper()
+
+	page, err := os.ReadFile(filepath.Join("..", "puzzle", "testdata", "day.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case status != http.StatusOK:
+			w.WriteHeader(status)
+		case strings.HasSuffix(r.URL.Path, "/input"):
+			_, _ = w.Write([]byte(testInput))
+		default:
+			_, _ = w.Write(page)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestFs returns an in-memory working directory with a go.mod and a solution registry.
+func newTestFs(t *testing.T) afero.Fs {
+	t.Helper()
+
+	fsys := afero.NewMemMapFs()
+	writeTestFile(t, fsys, filepath.Join(testWorkDir, "go.mod"), "module example.com/aoc\n")
+	writeTestFile(t, fsys, filepath.Join(testWorkDir, "solutions", "solutions.go"), "package solutions\n")
+	return fsys
+}
+
+// testConfig returns the configuration for creating day 5 of 2024 in fsys with files from server.
+func testConfig(t *testing.T, fsys afero.Fs, server *httptest.Server, cookie string) Config {
+	t.Helper()
+
+	client, err := aoc.NewClient(aoc.Config{BaseURL: server.URL, Cookie: cookie, CacheDir: t.TempDir(), MinInterval: -1})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	return Config{
+		Year:     2024,
+		Day:      5,
+		WorkDir:  testWorkDir,
+		Client:   client,
+		Fs:       fsys,
+		Reporter: ui.NewQuiet(io.Discard, io.Discard),
+		Confirm: func(year, day int, relPath string) (bool, error) {
+			t.Errorf("Confirm() called for day %d of %d", day, year)
+			return false, nil
+		},
+	}
+}
+
+func writeTestFile(t *testing.T, fsys afero.Fs, path, content string) {
+	t.Helper()
+
+	if err := fsys.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := afero.WriteFile(fsys, path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestFile(t *testing.T, fsys afero.Fs, path string) string {
+	t.Helper()
+
+	data, err := afero.ReadFile(fsys, path)
+	if err != nil {
+		t.Fatalf("ReadFile(%s) error = %v", path, err)
+	}
+	return string(data)
+}
+
+func TestNewGenerator(t *testing.T) {
+	server := newPuzzleServer(t, http.StatusOK)
+
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr error
+	}{
+		{"Valid", func(cfg *Config) {}, nil},
+		{"Day 0", func(cfg *Config) { cfg.Day = 0 }, ErrInvalidDay},
+		{"Day 26", func(cfg *Config) { cfg.Day = 26 }, ErrInvalidDay},
+		{"Day 13 from 2025", func(cfg *Config) { cfg.Year, cfg.Day = 2025, 13 }, ErrInvalidDay},
+		{"Day 12 from 2025", func(cfg *Config) { cfg.Year, cfg.Day = 2025, 12 }, nil},
+		{"Year 0", func(cfg *Config) { cfg.Year = 0 }, ErrInvalidYear},
+		{"No workdir", func(cfg *Config) { cfg.WorkDir = "" }, ErrWorkdirRequired},
+		{"No client", func(cfg *Config) { cfg.Client = nil }, ErrClientRequired},
+		{"No reporter", func(cfg *Config) { cfg.Reporter = nil }, ErrReporterRequired},
+		{"Missing template dir", func(cfg *Config) { cfg.TemplateDir = "/templates" }, ErrTemplateDirNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig(t, newTestFs(t), server, "session")
+			tt.modify(&cfg)
+
+			_, err := NewGenerator(cfg)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewGenerator() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerator_Run(t *testing.T) {
+	fsys := newTestFs(t)
+	g, err := NewGenerator(testConfig(t, fsys, newPuzzleServer(t, http.StatusOK), "session"))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{"Solution", filepath.Join(testDayDir, "solution.go"), "// PartOne solves the first problem of day 5 of Advent of Code 2024."},
+		{"Example test", filepath.Join(testDayDir, "solution_test.go"), "func ExamplePartOne()"},
+		{"Description", filepath.Join(testDayDir, "README.md"), "# Day 1: Trebuchet?!"},
+		{"Example input", filepath.Join(testDayDir, "testdata", "example1.txt"), "1abc2"},
+		{"Example test package", filepath.Join(testDayDir, "part1_test.go"), "package d05\n"},
+		{"Example table", filepath.Join(testDayDir, "part1_test.go"), `{"testdata/example1.txt", "142"}`},
+		{"Input", filepath.Join(testDayDir, "testdata", "input.txt"), testInput},
+		{"Registry", filepath.Join(testWorkDir, "solutions", "registry_gen.go"), `y2024d05 "example.com/aoc/y2024/d05"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := readTestFile(t, fsys, tt.path); !strings.Contains(got, tt.want) {
+				t.Errorf("%s = %q, want it to contain %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerator_RunWithoutCookie(t *testing.T) {
+	fsys := newTestFs(t)
+	g, err := NewGenerator(testConfig(t, fsys, newPuzzleServer(t, http.StatusOK), ""))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if exists, _ := afero.Exists(fsys, filepath.Join(testDayDir, "testdata", "input.txt")); exists {
+		t.Errorf("Run() downloaded the input without a cookie")
+	}
+	if exists, _ := afero.Exists(fsys, filepath.Join(testDayDir, "solution.go")); !exists {
+		t.Errorf("Run() did not create solution.go")
+	}
+}
+
+func TestGenerator_RunWithBrokenDay(t *testing.T) {
+	fsys := newTestFs(t)
+	brokenDir := filepath.Join(testWorkDir, "y2024", "d04")
+	writeTestFile(t, fsys, filepath.Join(brokenDir, "solution.go"), "package d04\n\nfunc PartOne( {}\n")
+
+	var out strings.Builder
+	cfg := testConfig(t, fsys, newPuzzleServer(t, http.StatusOK), "session")
+	cfg.Reporter = ui.NewJSON(&out)
+	g, err := NewGenerator(cfg)
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	registry := readTestFile(t, fsys, filepath.Join(testWorkDir, registryFile))
+	if !strings.Contains(registry, "y2024d05.PartOne") || strings.Contains(registry, "y2024d04") {
+		t.Errorf("registry should only contain day 5:\n%s", registry)
+	}
+	if !strings.Contains(out.String(), `"event":"warning"`) || !strings.Contains(out.String(), "d04") {
+		t.Errorf("Run() reported %q, want a warning about day 4", out.String())
+	}
+}
+
+func TestGenerator_Overwrite(t *testing.T) {
+	server := newPuzzleServer(t, http.StatusOK)
+	promptErr := errors.New("no terminal")
+
+	tests := []struct {
+		name    string
+		force   bool
+		noInput bool
+		// confirm is the answer of the prompt, or nil if there must be no prompt.
+		confirm       func(year, day int, relPath string) (bool, error)
+		wantErr       error
+		wantRecreated bool
+	}{
+		{
+			name:          "Confirmed",
+			confirm:       func(year, day int, relPath string) (bool, error) { return true, nil },
+			wantRecreated: true,
+		},
+		{
+			name:    "Declined",
+			confirm: func(year, day int, relPath string) (bool, error) { return false, nil },
+			wantErr: ui.ErrCancelled,
+		},
+		{
+			name:    "Prompt failed",
+			confirm: func(year, day int, relPath string) (bool, error) { return false, promptErr },
+			wantErr: promptErr,
+		},
+		{name: "Force", force: true, wantRecreated: true},
+		{name: "No input", noInput: true, wantErr: ErrDayExists},
+		{name: "Force wins over no input", force: true, noInput: true, wantRecreated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := newTestFs(t)
+			solution := filepath.Join(testDayDir, "solution.go")
+			notes := filepath.Join(testDayDir, "notes.txt")
+			writeTestFile(t, fsys, solution, "package d05 // solved\n")
+			writeTestFile(t, fsys, notes, "ideas\n")
+
+			cfg := testConfig(t, fsys, server, "session")
+			cfg.Force = tt.force
+			cfg.NoInput = tt.noInput
+			if tt.confirm != nil {
+				cfg.Confirm = func(year, day int, relPath string) (bool, error) {
+					if year != 2024 || day != 5 || !strings.HasSuffix(relPath, filepath.Join("y2024", "d05")) {
+						t.Errorf("Confirm(%d, %d, %q), want day 5 of 2024", year, day, relPath)
+					}
+					return tt.confirm(year, day, relPath)
+				}
+			}
+
+			g, err := NewGenerator(cfg)
+			if err != nil {
+				t.Fatalf("NewGenerator() error = %v", err)
+			}
+
+			err = g.Run()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Run() error = %v, want %v", err, tt.wantErr)
+			}
+
+			notesExist, _ := afero.Exists(fsys, notes)
+			solved := strings.Contains(readTestFile(t, fsys, solution), "solved")
+			if tt.wantRecreated {
+				if notesExist || solved {
+					t.Errorf("Run() kept the existing day, want it recreated")
+				}
+			} else if !notesExist || !solved {
+				t.Errorf("Run() changed the existing day, want it untouched")
+			}
+		})
+	}
+}
+
+func TestGenerator_DownloadFailure(t *testing.T) {
+	tests := []struct {
+		status     int
+		wantReason string
+	}{
+		{http.StatusBadRequest, "authentication failed - check your session cookie"},
+		{http.StatusUnauthorized, "authentication failed - check your session cookie"},
+		{http.StatusForbidden, "authentication failed - check your session cookie"},
+		{http.StatusNotFound, "puzzle not available - it may not be released yet"},
+		{http.StatusInternalServerError, "500 Internal Server Error"},
+		{http.StatusServiceUnavailable, "503 Service Unavailable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			fsys := newTestFs(t)
+			g, err := NewGenerator(testConfig(t, fsys, newPuzzleServer(t, tt.status), "session"))
+			if err != nil {
+				t.Fatalf("NewGenerator() error = %v", err)
+			}
+
+			err = g.Run()
+			var downloadErr *DownloadError
+			if !errors.As(err, &downloadErr) {
+				t.Fatalf("Run() error = %v, want a DownloadError", err)
+			}
+			if downloadErr.Status != tt.status || downloadErr.Reason != tt.wantReason {
+				t.Errorf("Run() error = %+v, want status %d and reason %q", downloadErr, tt.status, tt.wantReason)
+			}
+
+			// The puzzle description is optional, so the scaffold is still created
+			if exists, _ := afero.Exists(fsys, filepath.Join(testDayDir, "solution.go")); !exists {
+				t.Errorf("Run() did not create solution.go")
+			}
+			if exists, _ := afero.Exists(fsys, filepath.Join(testDayDir, "README.md")); exists {
+				t.Errorf("Run() wrote README.md without a puzzle description")
+			}
+		})
+	}
+}
+
+func TestGenerator_InvalidInput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/input") {
+			_, _ = w.Write([]byte("<!DOCTYPE html>\n<html></html>\n"))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	fsys := newTestFs(t)
+	g, err := NewGenerator(testConfig(t, fsys, server, "session"))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	err = g.Run()
+	var downloadErr *DownloadError
+	if !errors.As(err, &downloadErr) || downloadErr.Reason != aoc.ErrInputHTML.Error() {
+		t.Fatalf("Run() error = %v, want a DownloadError %q", err, aoc.ErrInputHTML)
+	}
+	if exists, _ := afero.Exists(fsys, filepath.Join(testDayDir, "testdata", "input.txt")); exists {
+		t.Errorf("Run() wrote an invalid input.txt")
+	}
+}
+
+func TestGenerator_Templates(t *testing.T) {
+	server := newPuzzleServer(t, http.StatusOK)
+
+	tests := []struct {
+		name      string
+		templates map[string]string
+		want      map[string]string
+		wantErr   bool
+	}{
+		{
+			name: "Nested files",
+			templates: map[string]string{
+				"main.go.tmpl":  "package {{ .Package }} // {{ .Title }}\n",
+				"notes/todo.md": "Day {{ .PaddedDay }} of {{ .Year }} in {{ .ModulePath }}\n",
+			},
+			want: map[string]string{
+				"main.go":       "package d05 // Day 1: Trebuchet?!\n",
+				"notes/todo.md": "Day 05 of 2024 in example.com/aoc\n",
+			},
+		},
+		{
+			name:      "Invalid template",
+			templates: map[string]string{"main.go.tmpl": "{{ .Unclosed "},
+			wantErr:   true,
+		},
+		{
+			name:      "Unknown field",
+			templates: map[string]string{"main.go.tmpl": "{{ .Answer }}"},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := newTestFs(t)
+			for name, content := range tt.templates {
+				writeTestFile(t, fsys, filepath.Join("/templates", name), content)
+			}
+
+			cfg := testConfig(t, fsys, server, "session")
+			cfg.TemplateDir = "/templates"
+			g, err := NewGenerator(cfg)
+			if err != nil {
+				t.Fatalf("NewGenerator() error = %v", err)
+			}
+
+			err = g.Run()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for name, want := range tt.want {
+				if got := readTestFile(t, fsys, filepath.Join(testDayDir, name)); got != want {
+					t.Errorf("%s = %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_Refresh(t *testing.T) {
+	server := newPuzzleServer(t, http.StatusOK)
+
+	t.Run("Existing day", func(t *testing.T) {
+		fsys := newTestFs(t)
+		writeTestFile(t, fsys, filepath.Join(testDayDir, "solution.go"), "package d05 // solved\n")
+
+		g, err := NewGenerator(testConfig(t, fsys, server, "session"))
+		if err != nil {
+			t.Fatalf("NewGenerator() error = %v", err)
+		}
+		if err := g.Refresh(); err != nil {
+			t.Fatalf("Refresh() error = %v", err)
+		}
+
+		if got := readTestFile(t, fsys, filepath.Join(testDayDir, "solution.go")); got != "package d05 // solved\n" {
+			t.Errorf("Refresh() changed solution.go to %q", got)
+		}
+		if exists, _ := afero.Exists(fsys, filepath.Join(testDayDir, "README.md")); !exists {
+			t.Errorf("Refresh() did not write README.md")
+		}
+	})
+
+	t.Run("Missing day", func(t *testing.T) {
+		g, err := NewGenerator(testConfig(t, newTestFs(t), server, "session"))
+		if err != nil {
+			t.Fatalf("NewGenerator() error = %v", err)
+		}
+		if err := g.Refresh(); !errors.Is(err, ErrDayNotCreated) {
+			t.Errorf("Refresh() error = %v, want %v", err, ErrDayNotCreated)
+		}
+	})
+}
+
+// statErrFs fails to stat files below dir, e.g. because of missing permissions.
+type statErrFs struct {
+	afero.Fs
+	dir string
+}
+
+func (f statErrFs) Stat(name string) (os.FileInfo, error) {
+	if strings.HasPrefix(name, f.dir) {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrPermission}
+	}
+	return f.Fs.Stat(name)
+}
+
+func TestFileExists(t *testing.T) {
+	fsys := newTestFs(t)
+	writeTestFile(t, fsys, filepath.Join(testDayDir, "solution.go"), "package d05\n")
+
+	tests := []struct {
+		name    string
+		fsys    afero.Fs
+		path    string
+		want    bool
+		wantErr error
+	}{
+		{"File", fsys, filepath.Join(testDayDir, "solution.go"), true, nil},
+		{"Directory", fsys, testDayDir, false, nil},
+		{"Missing", fsys, filepath.Join(testDayDir, "input.txt"), false, nil},
+		{"Stat fails", statErrFs{Fs: fsys, dir: testDayDir}, filepath.Join(testDayDir, "solution.go"), false, os.ErrPermission},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fileExists(tt.fsys, tt.path)
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Errorf("fileExists(%s) = %v, %v, want %v, %v", tt.path, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerator_RunStatError(t *testing.T) {
+	fsys := statErrFs{Fs: newTestFs(t), dir: testDayDir}
+	g, err := NewGenerator(testConfig(t, fsys, newPuzzleServer(t, http.StatusOK), "session"))
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	if err := g.Run(); !errors.Is(err, os.ErrPermission) {
+		t.Errorf("Run() error = %v, want %v", err, os.ErrPermission)
+	}
+}
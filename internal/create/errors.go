@@ -10,11 +10,18 @@ import (
 
 // Domain-specific errors
 var (
-	ErrFileExists      = errors.New("file already exists")
-	ErrInvalidDay      = errors.New("invalid day")
-	ErrInvalidYear     = errors.New("invalid year")
-	ErrWorkdirRequired = errors.New("workdir is required")
-	ErrCookieRequired  = errors.New("cookie is required")
+	ErrFileExists          = errors.New("file already exists")
+	ErrInvalidDay          = errors.New("invalid day")
+	ErrInvalidYear         = errors.New("invalid year")
+	ErrWorkdirRequired     = errors.New("workdir is required")
+	ErrCookieRequired      = errors.New("cookie is required")
+	ErrClientRequired      = errors.New("client is required")
+	ErrReporterRequired    = errors.New("reporter is required")
+	ErrNoRegistry          = errors.New("workdir has no solution registry")
+	ErrDayNotCreated       = errors.New("day has not been created yet")
+	ErrTemplateDirNotFound = errors.New("template directory not found")
+	ErrDayExists           = errors.New("day already exists")
+	ErrMissingParts        = errors.New("day cannot be registered")
 )
 
 // FileExistsError represents a file that already exists
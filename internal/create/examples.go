@@ -0,0 +1,139 @@
+package create
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/frederik-suerig/advent-of-code/internal/puzzle"
+	"github.com/spf13/afero"
+)
+
+//go:embed templates/part_test.go.tmpl
+var partTestTemplate string
+
+type exampleCase struct {
+	Input    string
+	Expected string
+}
+
+type partTestData struct {
+	Day      int
+	Year     int
+	Package  string
+	Func     string
+	Examples []exampleCase
+}
+
+// partFuncs maps each part to the function solving it.
+var partFuncs = map[int]string{
+	1: "PartOne",
+	2: "PartTwo",
+}
+
+// writeExamples stores the examples of the puzzle page as testdata/exampleN.txt and
+// generates a table-driven test per part. Existing tests are never overwritten, so
+// refreshing after part one is solved only adds the test of part two.
+// With a template directory only the examples are stored, as the tests would call
+// PartOne and PartTwo, which the templates don't have to declare.
+func (g *Generator) writeExamples(page []byte) error {
+	examples, err := puzzle.ParseExamples(bytes.NewReader(page), g.heuristics)
+	if err != nil {
+		return fmt.Errorf("failed to parse examples: %w", err)
+	}
+
+	cases := make(map[int][]exampleCase)
+	for _, ex := range examples {
+		testPath := filepath.Join(g.outputDir, fmt.Sprintf("part%d_test.go", ex.Part))
+		exists, err := fileExists(g.fs, testPath)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		input, err := g.writeExampleInput(ex.Input)
+		if err != nil {
+			return err
+		}
+		cases[ex.Part] = append(cases[ex.Part], exampleCase{Input: input, Expected: ex.Answer})
+	}
+
+	if g.templateDir != "" {
+		return nil
+	}
+
+	for _, part := range []int{1, 2} {
+		if len(cases[part]) == 0 {
+			continue
+		}
+		if err := g.renderPartTest(part, cases[part]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeExampleInput writes an example input to the next free testdata/exampleN.txt
+// and returns its path relative to the day. Inputs that already exist are reused.
+func (g *Generator) writeExampleInput(input string) (string, error) {
+	dir := filepath.Join(g.outputDir, "testdata")
+	if err := g.fs.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	for n := 1; ; n++ {
+		name := fmt.Sprintf("example%d.txt", n)
+		path := filepath.Join(dir, name)
+
+		existing, err := afero.ReadFile(g.fs, path)
+		if err == nil {
+			if string(existing) == input {
+				return "testdata/" + name, nil
+			}
+			continue
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("failed to read example: %w", err)
+		}
+
+		if err := afero.WriteFile(g.fs, path, []byte(input), 0644); err != nil {
+			return "", fmt.Errorf("failed to write example: %w", err)
+		}
+		g.reporter.FileCreated(path)
+		return "testdata/" + name, nil
+	}
+}
+
+func (g *Generator) renderPartTest(part int, cases []exampleCase) error {
+	path := filepath.Join(g.outputDir, fmt.Sprintf("part%d_test.go", part))
+
+	tmpl, err := template.New("part_test").Parse(partTestTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, partTestData{
+		Day:      g.day,
+		Year:     g.year,
+		Package:  g.packageName(),
+		Func:     partFuncs[part],
+		Examples: cases,
+	}); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	if err := afero.WriteFile(g.fs, path, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+
+	g.reporter.FileCreated(path)
+	return nil
+}
@@ -0,0 +1,182 @@
+package create
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/frederik-suerig/advent-of-code/internal/gomod"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+	"github.com/spf13/afero"
+)
+
+//go:embed templates/registry_gen.go.tmpl
+var registryTemplate string
+
+// registryFile is the generated registry, relative to the working directory.
+var registryFile = filepath.Join("solutions", "registry_gen.go")
+
+type registryDay struct {
+	Year       int
+	Day        int
+	Alias      string
+	ImportPath string
+}
+
+type registryData struct {
+	Days []registryDay
+}
+
+// UpdateRegistry regenerates the solution registry in workDir from the days that exist on disk.
+// Days that don't declare the functions of both parts are left out, as the registry would not
+// compile; the reason for each of them is returned in skipped, wrapping ErrMissingParts.
+// Returns ErrNoRegistry if workDir has no solutions package.
+func UpdateRegistry(workDir string) (skipped []error, err error) {
+	return updateRegistry(afero.NewOsFs(), workDir)
+}
+
+func updateRegistry(fsys afero.Fs, workDir string) ([]error, error) {
+	exists, err := fileExists(fsys, filepath.Join(workDir, "solutions", "solutions.go"))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrNoRegistry
+	}
+
+	module, err := gomod.ModulePathFs(fsys, workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	days, skipped, err := findDays(fsys, workDir, module)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := template.New("registry").Parse(registryTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, registryData{Days: days}); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format registry: %w", err)
+	}
+
+	if err := afero.WriteFile(fsys, filepath.Join(workDir, registryFile), src, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write registry: %w", err)
+	}
+	return skipped, nil
+}
+
+// findDays returns all days in workDir that have a solution, sorted by year and day,
+// and why the days that don't declare the functions of both parts were skipped.
+func findDays(fsys afero.Fs, workDir, module string) ([]registryDay, []error, error) {
+	matches, err := afero.Glob(fsys, filepath.Join(workDir, "y[0-9][0-9][0-9][0-9]", "d[0-9][0-9]", "solution.go"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find solutions: %w", err)
+	}
+
+	days := make([]registryDay, 0, len(matches))
+	var skipped []error
+	for _, match := range matches {
+		dayDir := filepath.Dir(match)
+		var year, day int
+		if _, err := fmt.Sscanf(filepath.Base(filepath.Dir(dayDir))+filepath.Base(dayDir), "y%04dd%02d", &year, &day); err != nil {
+			continue
+		}
+		if err := checkPartFuncs(fsys, dayDir); err != nil {
+			skipped = append(skipped, err)
+			continue
+		}
+		days = append(days, registryDay{
+			Year:       year,
+			Day:        day,
+			Alias:      fmt.Sprintf("y%04dd%02d", year, day),
+			ImportPath: fmt.Sprintf("%s/y%04d/d%02d", module, year, day),
+		})
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		if days[i].Year != days[j].Year {
+			return days[i].Year < days[j].Year
+		}
+		return days[i].Day < days[j].Day
+	})
+	return days, skipped, nil
+}
+
+// checkPartFuncs parses the Go files of a day and checks that they declare the functions of both parts.
+func checkPartFuncs(fsys afero.Fs, dayDir string) error {
+	files, err := afero.Glob(fsys, filepath.Join(dayDir, "*.go"))
+	if err != nil {
+		return fmt.Errorf("failed to find files of %s: %w", dayDir, err)
+	}
+
+	declared := make(map[string]bool)
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		src, err := afero.ReadFile(fsys, file)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		f, err := parser.ParseFile(fset, file, src, parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrMissingParts, ui.MakeRelative(dayDir), err)
+		}
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+				declared[fn.Name.Name] = true
+			}
+		}
+	}
+
+	var missing []string
+	for part := 1; part <= len(partFuncs); part++ {
+		if name := partFuncs[part]; !declared[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s does not declare %s", ErrMissingParts, ui.MakeRelative(dayDir), strings.Join(missing, " and "))
+	}
+	return nil
+}
+
+// updateRegistry keeps the registry in sync after days were created or deleted.
+// Working directories without a solutions package are left alone, and days that can't
+// be registered only cause a warning, so they don't block creating other days.
+func (g *Generator) updateRegistry() error {
+	skipped, err := updateRegistry(g.fs, g.workDir)
+	if err != nil {
+		if errors.Is(err, ErrNoRegistry) {
+			return nil
+		}
+		return err
+	}
+
+	for _, reason := range skipped {
+		g.reporter.Warning("%s - left out of the solution registry", reason)
+	}
+
+	g.reporter.DimText("  Updated solution registry %s", ui.MakeRelative(filepath.Join(g.workDir, registryFile)))
+	return nil
+}
@@ -0,0 +1,79 @@
+package create
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUpdateRegistry(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		// wantSkip is true if the day is left out of the registry
+		wantSkip bool
+	}{
+		{
+			name:  "Both parts",
+			files: map[string]string{"solution.go": "package d05\n\nfunc PartOne() {}\n\nfunc PartTwo() {}\n"},
+		},
+		{
+			name: "Parts in several files",
+			files: map[string]string{
+				"solution.go": "package d05\n\nfunc PartOne() {}\n",
+				"two.go":      "package d05\n\nfunc PartTwo() {}\n",
+			},
+		},
+		{
+			name: "Part only in a test",
+			files: map[string]string{
+				"solution.go":      "package d05\n\nfunc PartOne() {}\n",
+				"solution_test.go": "package d05\n\nfunc PartTwo() {}\n",
+			},
+			wantSkip: true,
+		},
+		{
+			name:     "Method instead of function",
+			files:    map[string]string{"solution.go": "package d05\n\ntype s struct{}\n\nfunc PartOne() {}\n\nfunc (s) PartTwo() {}\n"},
+			wantSkip: true,
+		},
+		{
+			name:     "Renamed functions",
+			files:    map[string]string{"solution.go": "package d05\n\nfunc Solve1() {}\n\nfunc Solve2() {}\n"},
+			wantSkip: true,
+		},
+		{
+			name:     "Syntax error",
+			files:    map[string]string{"solution.go": "package d05\n\nfunc PartOne( {}\n"},
+			wantSkip: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := newTestFs(t)
+			for name, content := range tt.files {
+				writeTestFile(t, fsys, filepath.Join(testDayDir, name), content)
+			}
+
+			skipped, err := updateRegistry(fsys, testWorkDir)
+			if err != nil {
+				t.Fatalf("updateRegistry() error = %v", err)
+			}
+
+			if tt.wantSkip {
+				if len(skipped) != 1 || !errors.Is(skipped[0], ErrMissingParts) {
+					t.Errorf("updateRegistry() skipped = %v, want %v", skipped, ErrMissingParts)
+				}
+			} else if len(skipped) > 0 {
+				t.Errorf("updateRegistry() skipped = %v, want none", skipped)
+			}
+
+			registry := readTestFile(t, fsys, filepath.Join(testWorkDir, registryFile))
+			if registered := strings.Contains(registry, "y2024d05."); registered == tt.wantSkip {
+				t.Errorf("registry contains day 5 = %v, want %v:\n%s", registered, !tt.wantSkip, registry)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package create
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/frederik-suerig/advent-of-code/internal/gomod"
+	"github.com/frederik-suerig/advent-of-code/internal/puzzle"
+	"github.com/spf13/afero"
+)
+
+// templateSuffix is stripped from the names of rendered files.
+const templateSuffix = ".tmpl"
+
+//go:embed templates/day
+var embeddedTemplates embed.FS
+
+// defaultTemplates is the template set used if no template directory is configured.
+var defaultTemplates = mustSub(embeddedTemplates, "templates/day")
+
+// templateData is available in every template of a day.
+type templateData struct {
+	Day  int
+	Year int
+	// PaddedDay is the day with a leading zero, e.g. "05".
+	PaddedDay string
+	// Package is the package name of the day, e.g. "d05".
+	Package string
+	// Title is the title of the puzzle, e.g. "Day 5: Print Queue", or empty if it could not be downloaded.
+	Title string
+	// ModulePath is the module path of the working directory, or empty if it has no go.mod.
+	ModulePath string
+	// InputPath is the path of the puzzle input, relative to the day.
+	InputPath string
+}
+
+func mustSub(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
+// templates returns the template set to render into the day.
+func (g *Generator) templates() fs.FS {
+	if g.templateDir != "" {
+		return afero.NewIOFS(afero.NewBasePathFs(g.fs, g.templateDir))
+	}
+	return defaultTemplates
+}
+
+// packageName returns the package name of the day, e.g. "d05".
+func (g *Generator) packageName() string {
+	return fmt.Sprintf("d%02d", g.day)
+}
+
+// templateData returns the data passed to the templates. The puzzle page may be nil.
+func (g *Generator) templateData(page []byte) templateData {
+	padded := fmt.Sprintf("%02d", g.day)
+	data := templateData{
+		Day:       g.day,
+		Year:      g.year,
+		PaddedDay: padded,
+		Package:   g.packageName(),
+		InputPath: path.Join("testdata", "input.txt"),
+	}
+
+	if module, err := gomod.ModulePathFs(g.fs, g.workDir); err == nil {
+		data.ModulePath = module
+	}
+	if page != nil {
+		if desc, err := puzzle.ParseDescription(bytes.NewReader(page), g.client.PuzzleURL(g.year, g.day)); err == nil {
+			data.Title = desc.Title
+		}
+	}
+	return data
+}
+
+// renderTemplates renders every file of the template set into the day, keeping the
+// directory structure and removing the .tmpl suffix from file names.
+func (g *Generator) renderTemplates(data templateData) error {
+	templates := g.templates()
+	return fs.WalkDir(templates, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("failed to read templates: %w", err)
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		text, err := fs.ReadFile(templates, name)
+		if err != nil {
+			return fmt.Errorf("failed to read template %s: %w", name, err)
+		}
+		return g.renderTemplate(string(text), strings.TrimSuffix(name, templateSuffix), data)
+	})
+}
+
+func (g *Generator) renderTemplate(templateText, filename string, data templateData) error {
+	path := filepath.Join(g.outputDir, filepath.FromSlash(filename))
+
+	exists, err := fileExists(g.fs, path)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return NewFileExistsError(path)
+	}
+
+	tmpl, err := template.New(filename).Parse(templateText)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	if err := g.fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	f, err := g.fs.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil {
+			// Log but don't fail if close fails after successful write
+			g.reporter.Warning("Failed to close file %s: %v", path, closeErr)
+		}
+	}()
+
+	if err := tmpl.Execute(f, data); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	g.reporter.FileCreated(path)
+	return nil
+}
@@ -0,0 +1,101 @@
+package create
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+)
+
+func TestGenerator_TemplateDir(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("..", "puzzle", "testdata", "day.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(page)
+	}))
+	defer server.Close()
+
+	client, err := aoc.NewClient(aoc.Config{BaseURL: server.URL, CacheDir: t.TempDir(), MinInterval: -1})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	templateDir := t.TempDir()
+	files := map[string]string{
+		"main.go.tmpl":      "package {{ .Package }} // {{ .Title }}\n\nconst input = \"{{ .InputPath }}\" // {{ .ModulePath }}\n",
+		"notes/todo.md":     "Day {{ .PaddedDay }} of {{ .Year }} <b>&</b>\n",
+		"testdata/.gitkeep": "",
+	}
+	for name, content := range files {
+		path := filepath.Join(templateDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	workDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(workDir, "go.mod"), []byte("module example.com/aoc\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := NewGenerator(Config{Year: 2024, Day: 5, WorkDir: workDir, TemplateDir: templateDir, Client: client, Reporter: ui.NewQuiet(io.Discard, io.Discard)})
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "main.go", want: "package d05 // Day 1: Trebuchet?!\n\nconst input = \"testdata/input.txt\" // example.com/aoc\n"},
+		{name: "notes/todo.md", want: "Day 05 of 2024 <b>&</b>\n"},
+		{name: "testdata/.gitkeep", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := os.ReadFile(filepath.Join(workDir, "y2024", "d05", tt.name))
+			if err != nil {
+				t.Fatalf("ReadFile() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("%s = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := os.Stat(filepath.Join(workDir, "y2024", "d05", "testdata", "example1.txt")); err != nil {
+		t.Errorf("examples were not stored: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(workDir, "y2024", "d05", "part1_test.go")); !os.IsNotExist(err) {
+		t.Errorf("built-in part1_test.go was generated despite a template directory")
+	}
+
+	if _, err := os.Stat(filepath.Join(workDir, "y2024", "d05", "solution.go")); !os.IsNotExist(err) {
+		t.Errorf("built-in solution.go was rendered despite a template directory")
+	}
+}
+
+func TestNewGenerator_TemplateDirNotFound(t *testing.T) {
+	client, err := aoc.NewClient(aoc.Config{CacheDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	_, err = NewGenerator(Config{Year: 2024, Day: 5, WorkDir: t.TempDir(), TemplateDir: filepath.Join(t.TempDir(), "missing"), Client: client, Reporter: ui.NewQuiet(io.Discard, io.Discard)})
+	if err == nil {
+		t.Errorf("NewGenerator() error = nil, want %v", ErrTemplateDirNotFound)
+	}
+}
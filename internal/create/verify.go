@@ -0,0 +1,49 @@
+package create
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/spf13/afero"
+)
+
+// InputCheck is the result of checking the input of a single day with VerifyInputs
+type InputCheck struct {
+	Day  int
+	Path string
+	// Err is a DownloadError explaining why the input is invalid, or nil if it is fine.
+	Err error
+}
+
+// VerifyInputs checks the testdata/input.txt of every day of a year in workDir with
+// aoc.ValidateInput, e.g. to find inputs downloaded before inputs were validated.
+// Days without an input are not checked.
+func VerifyInputs(fsys afero.Fs, workDir string, year int) ([]InputCheck, error) {
+	if year <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidYear, year)
+	}
+	if workDir == "" {
+		return nil, ErrWorkdirRequired
+	}
+
+	var checks []InputCheck
+	for day := 1; day <= aoc.DaysInYear(year); day++ {
+		path := filepath.Join(workDir, fmt.Sprintf("y%04d", year), fmt.Sprintf("d%02d", day), "testdata", "input.txt")
+		data, err := afero.ReadFile(fsys, path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read input file: %w", err)
+		}
+
+		check := InputCheck{Day: day, Path: path}
+		if err := aoc.ValidateInput(data); err != nil {
+			check.Err = NewDownloadError(err.Error(), 0)
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
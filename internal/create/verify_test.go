@@ -0,0 +1,64 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/spf13/afero"
+)
+
+func TestVerifyInputs(t *testing.T) {
+	fsys := newTestFs(t)
+	inputs := map[int]string{
+		1: testInput,
+		2: "<!DOCTYPE html>\n<html></html>\n",
+		3: "",
+		5: "47\n11",
+	}
+	for day, content := range inputs {
+		writeTestFile(t, fsys, filepath.Join(testWorkDir, "y2024", fmt.Sprintf("d%02d", day), "testdata", "input.txt"), content)
+	}
+	// A day without an input is not checked
+	writeTestFile(t, fsys, filepath.Join(testWorkDir, "y2024", "d04", "solution.go"), "package d04\n")
+
+	checks, err := VerifyInputs(fsys, testWorkDir, 2024)
+	if err != nil {
+		t.Fatalf("VerifyInputs() error = %v", err)
+	}
+
+	want := []struct {
+		day    int
+		reason error
+	}{
+		{1, nil},
+		{2, aoc.ErrInputHTML},
+		{3, aoc.ErrInputEmpty},
+		{5, aoc.ErrInputTruncated},
+	}
+	if len(checks) != len(want) {
+		t.Fatalf("VerifyInputs() returned %d checks, want %d", len(checks), len(want))
+	}
+	for i, w := range want {
+		check := checks[i]
+		if check.Day != w.day {
+			t.Errorf("VerifyInputs()[%d].Day = %d, want %d", i, check.Day, w.day)
+		}
+		if w.reason == nil {
+			if check.Err != nil {
+				t.Errorf("VerifyInputs() day %d error = %v, want nil", check.Day, check.Err)
+			}
+			continue
+		}
+		var downloadErr *DownloadError
+		if !errors.As(check.Err, &downloadErr) || downloadErr.Reason != w.reason.Error() {
+			t.Errorf("VerifyInputs() day %d error = %v, want a DownloadError %q", check.Day, check.Err, w.reason)
+		}
+	}
+
+	if _, err := VerifyInputs(afero.NewMemMapFs(), testWorkDir, 0); !errors.Is(err, ErrInvalidYear) {
+		t.Errorf("VerifyInputs() error = %v, want %v", err, ErrInvalidYear)
+	}
+}
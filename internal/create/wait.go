@@ -0,0 +1,69 @@
+package create
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+)
+
+const (
+	// initialBackoff is the delay before the first retry of a download that failed right after the unlock.
+	initialBackoff = 2 * time.Second
+	// maxBackoff caps the delay between two retries.
+	maxBackoff = 30 * time.Second
+	// maxAttempts is how often a download is tried before giving up.
+	maxAttempts = 10
+)
+
+// waitForUnlock blocks until the puzzle is released, showing a countdown.
+func (g *Generator) waitForUnlock() {
+	unlock := aoc.UnlockTime(g.year, g.day)
+	remaining := unlock.Sub(g.now())
+	if remaining <= 0 {
+		return
+	}
+
+	for remaining > 0 {
+		g.reporter.Countdown("Puzzle unlocks in %s", remaining)
+		// Sleep until the next full second, so the countdown ends exactly at the unlock
+		tick := remaining % time.Second
+		if tick == 0 {
+			tick = time.Second
+		}
+		g.sleep(tick)
+		remaining = unlock.Sub(g.now())
+	}
+	g.reporter.Countdown("", 0)
+	g.reporter.Info("Puzzle unlocked!")
+}
+
+// retry calls download until it succeeds if the generator waits for the unlock.
+// Not found and server errors are retried with exponential backoff, as the puzzle
+// may appear a moment late and the website is busy right after a release.
+func (g *Generator) retry(download func() error) error {
+	if !g.wait {
+		return download()
+	}
+
+	backoff := initialBackoff
+	for attempt := 1; ; attempt++ {
+		err := download()
+		if err == nil || attempt == maxAttempts || !retryable(err) {
+			return err
+		}
+
+		g.reporter.Warning("%s - retrying in %s", errorReason(err), backoff)
+		g.sleep(backoff)
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+func retryable(err error) bool {
+	var downloadErr *DownloadError
+	if !errors.As(err, &downloadErr) {
+		return false
+	}
+	return downloadErr.Status == http.StatusNotFound || downloadErr.Status >= http.StatusInternalServerError
+}
@@ -0,0 +1,179 @@
+package create
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+)
+
+// fakeClock advances its time whenever it sleeps.
+type fakeClock struct {
+	now    time.Time
+	slept  []time.Duration
+	asleep time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.slept = append(c.slept, d)
+	c.asleep += d
+	c.now = c.now.Add(d)
+}
+
+func TestGenerator_Wait(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("..", "puzzle", "testdata", "day.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unlock := aoc.UnlockTime(2024, 5)
+	clock := &fakeClock{now: unlock.Add(-2500 * time.Millisecond)}
+
+	// The puzzle appears a few seconds after the unlock and the first input request fails
+	puzzleReleased := unlock.Add(3 * time.Second)
+	inputRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if clock.now.Before(puzzleReleased) {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.URL.Path {
+		case "/2024/day/5":
+			_, _ = w.Write(page)
+		case "/2024/day/5/input":
+			inputRequests++
+			if inputRequests == 1 {
+				http.Error(w, "busy", http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte("1 2 3\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client, err := aoc.NewClient(aoc.Config{
+		BaseURL:     server.URL,
+		Cookie:      "secret",
+		CacheDir:    t.TempDir(),
+		MinInterval: -1,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	workDir := t.TempDir()
+	g, err := NewGenerator(Config{
+		Year:     2024,
+		Day:      5,
+		WorkDir:  workDir,
+		Client:   client,
+		Wait:     true,
+		Now:      clock.Now,
+		Sleep:    clock.Sleep,
+		Reporter: ui.NewQuiet(io.Discard, io.Discard),
+	})
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	if err := g.Run(); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	for _, name := range []string{"solution.go", "README.md", filepath.Join("testdata", "input.txt")} {
+		if _, err := os.Stat(filepath.Join(workDir, "y2024", "d05", name)); err != nil {
+			t.Errorf("%s was not created: %v", name, err)
+		}
+	}
+
+	// Countdown to the unlock, then the backoff of the puzzle and input downloads
+	want := []time.Duration{
+		500 * time.Millisecond, time.Second, time.Second,
+		2 * time.Second, 4 * time.Second,
+		2 * time.Second,
+	}
+	if len(clock.slept) != len(want) {
+		t.Fatalf("slept %v, want %v", clock.slept, want)
+	}
+	for i := range want {
+		if clock.slept[i] != want[i] {
+			t.Errorf("slept %v, want %v", clock.slept, want)
+			break
+		}
+	}
+}
+
+func TestGenerator_WaitForExistingDay(t *testing.T) {
+	clock := &fakeClock{now: aoc.UnlockTime(2024, 5).Add(-time.Hour)}
+	client, err := aoc.NewClient(aoc.Config{CacheDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	workDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(workDir, "y2024", "d05"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := NewGenerator(Config{
+		Year:     2024,
+		Day:      5,
+		WorkDir:  workDir,
+		Client:   client,
+		Wait:     true,
+		NoInput:  true,
+		Now:      clock.Now,
+		Sleep:    clock.Sleep,
+		Reporter: ui.NewQuiet(io.Discard, io.Discard),
+	})
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	if err := g.Run(); !errors.Is(err, ErrDayExists) {
+		t.Errorf("Run() error = %v, want %v", err, ErrDayExists)
+	}
+	if clock.asleep != 0 {
+		t.Errorf("Run() waited %s for a day that already exists", clock.asleep)
+	}
+}
+
+func TestGenerator_WaitAfterUnlock(t *testing.T) {
+	clock := &fakeClock{now: aoc.UnlockTime(2024, 5).Add(time.Hour)}
+	client, err := aoc.NewClient(aoc.Config{CacheDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	g, err := NewGenerator(Config{
+		Year:     2024,
+		Day:      5,
+		WorkDir:  t.TempDir(),
+		Client:   client,
+		Wait:     true,
+		Now:      clock.Now,
+		Sleep:    clock.Sleep,
+		Reporter: ui.NewQuiet(io.Discard, io.Discard),
+	})
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+
+	g.waitForUnlock()
+	if clock.asleep != 0 {
+		t.Errorf("slept %v after the unlock, want 0", clock.asleep)
+	}
+}
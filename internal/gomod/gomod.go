@@ -0,0 +1,43 @@
+package gomod
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// ErrModuleNotFound is returned when a directory has no go.mod declaring a module.
+var ErrModuleNotFound = errors.New("no go.mod found")
+
+// ModulePath reads the module path from the go.mod in dir.
+func ModulePath(dir string) (string, error) {
+	return ModulePathFs(afero.NewOsFs(), dir)
+}
+
+// ModulePathFs reads the module path from the go.mod in dir of fsys.
+func ModulePathFs(fsys afero.Fs, dir string) (string, error) {
+	f, err := fsys.Open(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return "", fmt.Errorf("%w in %s", ErrModuleNotFound, dir)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if module, ok := strings.CutPrefix(line, "module "); ok {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+	if s.Err() != nil {
+		return "", fmt.Errorf("failed to read go.mod: %w", s.Err())
+	}
+
+	return "", fmt.Errorf("%w in %s", ErrModuleNotFound, dir)
+}
@@ -0,0 +1,271 @@
+package list
+
+// Element is a handle to a value in a CircularList. It stays valid while other values are
+// inserted, moved or removed, so puzzles can keep pointers to the values they care about.
+type Element[T any] struct {
+	value T
+	prev  *Element[T]
+	next  *Element[T]
+	// list is nil once the element has been removed.
+	list *CircularList[T]
+}
+
+// Value returns the value of the element.
+func (e *Element[T]) Value() T {
+	return e.value
+}
+
+// Next returns the following element, wrapping around at the end of the circle.
+// Returns nil if the element has been removed.
+func (e *Element[T]) Next() *Element[T] {
+	return e.next
+}
+
+// Prev returns the preceding element, wrapping around at the start of the circle.
+// Returns nil if the element has been removed.
+func (e *Element[T]) Prev() *Element[T] {
+	return e.prev
+}
+
+// Step returns the element n places after this one, or before it if n is negative.
+// Returns nil if the element has been removed.
+func (e *Element[T]) Step(n int) *Element[T] {
+	if e.list == nil {
+		return nil
+	}
+
+	n %= e.list.size
+	curr := e
+	for ; n > 0; n-- {
+		curr = curr.next
+	}
+	for ; n < 0; n++ {
+		curr = curr.prev
+	}
+	return curr
+}
+
+// CircularList represents a double-linked list whose last element is followed by its first.
+// Inserting, removing and moving an element by its handle takes O(1).
+type CircularList[T any] struct {
+	// front is where ToSlice starts, the circle itself has no start.
+	front *Element[T]
+	size  int
+	// index maps values to their elements if the list was created by NewIndexedCircularList.
+	index map[any]*Element[T]
+}
+
+// NewCircularList creates a new empty circular list.
+func NewCircularList[T any]() *CircularList[T] {
+	return &CircularList[T]{}
+}
+
+// NewIndexedCircularList creates a new empty circular list that keeps an index of its values,
+// so Find takes O(1). The values should be unique, e.g. the labels of cups or marbles;
+// Find returns the latest inserted element of a duplicate value.
+func NewIndexedCircularList[T comparable]() *CircularList[T] {
+	return &CircularList[T]{
+		index: make(map[any]*Element[T]),
+	}
+}
+
+// PushBack adds a value before the front element, i.e. at the end of ToSlice, and returns its handle.
+// The first value pushed becomes the front element.
+func (l *CircularList[T]) PushBack(val T) *Element[T] {
+	if l.front == nil {
+		e := &Element[T]{value: val, list: l}
+		e.prev, e.next = e, e
+		l.front = e
+		l.size++
+		l.indexElement(e)
+		return e
+	}
+	return l.insertAfter(val, l.front.prev)
+}
+
+// InsertAfter inserts a value after mark and returns its handle.
+// Returns nil if mark is not an element of the list.
+func (l *CircularList[T]) InsertAfter(val T, mark *Element[T]) *Element[T] {
+	if !l.Contains(mark) {
+		return nil
+	}
+	return l.insertAfter(val, mark)
+}
+
+// InsertBefore inserts a value before mark and returns its handle.
+// Returns nil if mark is not an element of the list.
+func (l *CircularList[T]) InsertBefore(val T, mark *Element[T]) *Element[T] {
+	if !l.Contains(mark) {
+		return nil
+	}
+	return l.insertAfter(val, mark.prev)
+}
+
+// Remove removes an element from the list and returns its value.
+// Returns the value and true if the element was in the list.
+func (l *CircularList[T]) Remove(e *Element[T]) (T, bool) {
+	if !l.Contains(e) {
+		var zero T
+		return zero, false
+	}
+
+	if l.size == 1 {
+		l.front = nil
+	} else {
+		if l.front == e {
+			l.front = e.next
+		}
+		l.unlink(e, e)
+	}
+	l.size--
+
+	if l.index != nil && l.index[any(e.value)] == e {
+		delete(l.index, any(e.value))
+	}
+	e.prev, e.next, e.list = nil, nil, nil
+	return e.value, true
+}
+
+// Move moves an element n places towards its next elements, or towards its previous ones if
+// n is negative, e.g. moving a in [a b c d] by 1 gives [b a c d].
+// Returns true if the element is in the list.
+func (l *CircularList[T]) Move(e *Element[T], n int) bool {
+	if !l.Contains(e) {
+		return false
+	}
+
+	// Moving past all other elements brings e back to where it was
+	others := l.size - 1
+	if others == 0 {
+		return true
+	}
+	n %= others
+	if n < 0 {
+		n += others
+	}
+	if n == 0 {
+		return true
+	}
+
+	mark := e
+	for i := 0; i < n; i++ {
+		mark = mark.next
+	}
+	if l.front == e {
+		l.front = e.next
+	}
+	l.unlink(e, e)
+	l.link(e, e, mark)
+	return true
+}
+
+// Splice moves the elements from first to last, following Next, to after mark, keeping
+// their order, e.g. the three cups picked up in crab cups. It takes O(k) for k moved elements.
+// Returns false and changes nothing if an element is not in the list or mark is in the range.
+func (l *CircularList[T]) Splice(first, last, mark *Element[T]) bool {
+	if !l.Contains(first) || !l.Contains(last) || !l.Contains(mark) {
+		return false
+	}
+
+	moveFront := false
+	for e := first; ; e = e.next {
+		if e == mark {
+			return false
+		}
+		moveFront = moveFront || e == l.front
+		if e == last {
+			break
+		}
+	}
+
+	if moveFront {
+		l.front = last.next
+	}
+	l.unlink(first, last)
+	l.link(first, last, mark)
+	return true
+}
+
+// Find returns the element holding a value in O(1).
+// Returns nil and false if the value is not in the list or the list has no index.
+func (l *CircularList[T]) Find(val T) (*Element[T], bool) {
+	if l.index == nil {
+		return nil, false
+	}
+	e, ok := l.index[any(val)]
+	return e, ok
+}
+
+// Contains returns true if the element belongs to the list.
+func (l *CircularList[T]) Contains(e *Element[T]) bool {
+	return e != nil && e.list == l
+}
+
+// Front returns the element ToSlice starts with, or nil if the list is empty.
+func (l *CircularList[T]) Front() *Element[T] {
+	return l.front
+}
+
+// SetFront makes an element the one ToSlice starts with.
+// Returns true if the element is in the list.
+func (l *CircularList[T]) SetFront(e *Element[T]) bool {
+	if !l.Contains(e) {
+		return false
+	}
+	l.front = e
+	return true
+}
+
+// Size returns the number of elements in the list.
+func (l *CircularList[T]) Size() int {
+	return l.size
+}
+
+// IsEmpty returns true if the list is empty.
+func (l *CircularList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// ToSlice returns all values in the list as a slice, starting at the front element.
+func (l *CircularList[T]) ToSlice() []T {
+	result := make([]T, 0, l.size)
+	if l.front == nil {
+		return result
+	}
+
+	e := l.front
+	for range l.size {
+		result = append(result, e.value)
+		e = e.next
+	}
+	return result
+}
+
+func (l *CircularList[T]) insertAfter(val T, mark *Element[T]) *Element[T] {
+	e := &Element[T]{value: val, list: l}
+	l.link(e, e, mark)
+	l.size++
+	l.indexElement(e)
+	return e
+}
+
+// link inserts the chain of elements from first to last after mark.
+func (l *CircularList[T]) link(first, last, mark *Element[T]) {
+	first.prev = mark
+	last.next = mark.next
+	mark.next.prev = last
+	mark.next = first
+}
+
+// unlink takes the chain of elements from first to last out of the circle, leaving its
+// inner links untouched.
+func (l *CircularList[T]) unlink(first, last *Element[T]) {
+	first.prev.next = last.next
+	last.next.prev = first.prev
+}
+
+func (l *CircularList[T]) indexElement(e *Element[T]) {
+	if l.index != nil {
+		l.index[any(e.value)] = e
+	}
+}
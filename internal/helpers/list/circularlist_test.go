@@ -0,0 +1,158 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func newTestCircle(values ...int) (*CircularList[int], []*Element[int]) {
+	l := NewIndexedCircularList[int]()
+	elements := make([]*Element[int], len(values))
+	for i, v := range values {
+		elements[i] = l.PushBack(v)
+	}
+	return l, elements
+}
+
+func TestCircularList_InsertRemove(t *testing.T) {
+	l, e := newTestCircle(1, 2, 3)
+	if got, want := l.ToSlice(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Fatalf("ToSlice() = %v, want %v", got, want)
+	}
+	if e[2].Next() != e[0] || e[0].Prev() != e[2] {
+		t.Errorf("the last element is not linked to the first")
+	}
+
+	l.InsertAfter(4, e[0])
+	l.InsertBefore(5, e[0])
+	if got, want := l.ToSlice(), []int{1, 4, 2, 3, 5}; !slices.Equal(got, want) {
+		t.Errorf("ToSlice() after inserting = %v, want %v", got, want)
+	}
+
+	if v, ok := l.Remove(e[0]); !ok || v != 1 {
+		t.Errorf("Remove() = %d, %v, want 1, true", v, ok)
+	}
+	if _, ok := l.Remove(e[0]); ok {
+		t.Errorf("Remove() of a removed element ok = true, want false")
+	}
+	if l.InsertAfter(6, e[0]) != nil {
+		t.Errorf("InsertAfter() a removed element != nil")
+	}
+	if _, ok := l.Find(1); ok {
+		t.Errorf("Find() found a removed value")
+	}
+	if got, want := l.ToSlice(), []int{4, 2, 3, 5}; !slices.Equal(got, want) {
+		t.Errorf("ToSlice() after removing the front = %v, want %v", got, want)
+	}
+
+	for !l.IsEmpty() {
+		l.Remove(l.Front())
+	}
+	if l.Front() != nil || len(l.ToSlice()) != 0 {
+		t.Errorf("list is not empty after removing every element")
+	}
+}
+
+func TestCircularList_Move(t *testing.T) {
+	tests := []struct {
+		name string
+		move int
+		n    int
+		want []int
+	}{
+		{name: "forward", move: 0, n: 1, want: []int{1, 3, 4, 2}},
+		{name: "backward", move: 2, n: -1, want: []int{1, 3, 2, 4}},
+		{name: "around the circle", move: 1, n: 3, want: []int{1, 2, 3, 4}},
+		{name: "past the end", move: 3, n: 2, want: []int{1, 2, 4, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, e := newTestCircle(1, 2, 3, 4)
+			if !l.Move(e[tt.move], tt.n) {
+				t.Fatalf("Move() = false, want true")
+			}
+			l.SetFront(e[0])
+			if got := l.ToSlice(); !slices.Equal(got, tt.want) {
+				t.Errorf("Move(%d, %d) = %v, want %v", tt.move+1, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCircularList_Splice(t *testing.T) {
+	l, e := newTestCircle(1, 2, 3, 4, 5, 6)
+	if !l.Splice(e[1], e[3], e[4]) {
+		t.Fatalf("Splice() = false, want true")
+	}
+	if got, want := l.ToSlice(), []int{1, 5, 2, 3, 4, 6}; !slices.Equal(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+
+	// The range wraps around and contains the front
+	if !l.Splice(e[5], e[0], e[2]) {
+		t.Fatalf("Splice() = false, want true")
+	}
+	l.SetFront(e[4])
+	if got, want := l.ToSlice(), []int{5, 2, 3, 6, 1, 4}; !slices.Equal(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+
+	if l.Splice(e[1], e[3], e[2]) {
+		t.Errorf("Splice() into its own range = true, want false")
+	}
+}
+
+// TestCircularList_CrabCups plays the example of Advent of Code 2020 day 23.
+func TestCircularList_CrabCups(t *testing.T) {
+	l, _ := newTestCircle(3, 8, 9, 1, 2, 5, 4, 6, 7)
+	current := l.Front()
+	for range 10 {
+		first := current.Next()
+		last := first.Step(2)
+		picked := []int{first.Value(), first.Next().Value(), last.Value()}
+
+		label := current.Value()
+		for {
+			label--
+			if label == 0 {
+				label = l.Size()
+			}
+			if !slices.Contains(picked, label) {
+				break
+			}
+		}
+		dest, _ := l.Find(label)
+		l.Splice(first, last, dest)
+		current = current.Next()
+	}
+
+	one, _ := l.Find(1)
+	l.SetFront(one)
+	if got, want := l.ToSlice()[1:], []int{9, 2, 6, 5, 8, 3, 7, 4}; !slices.Equal(got, want) {
+		t.Errorf("labels after cup 1 = %v, want %v", got, want)
+	}
+}
+
+// TestCircularList_Marbles plays the example of Advent of Code 2018 day 9.
+func TestCircularList_Marbles(t *testing.T) {
+	players, lastMarble := 9, 25
+	scores := make([]int, players)
+
+	l := NewCircularList[int]()
+	current := l.PushBack(0)
+	for marble := 1; marble <= lastMarble; marble++ {
+		if marble%23 != 0 {
+			current = l.InsertAfter(marble, current.Next())
+			continue
+		}
+		removed := current.Step(-7)
+		current = removed.Next()
+		v, _ := l.Remove(removed)
+		scores[marble%players] += marble + v
+	}
+
+	if got := slices.Max(scores); got != 32 {
+		t.Errorf("high score = %d, want 32", got)
+	}
+}
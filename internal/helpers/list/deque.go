@@ -0,0 +1,165 @@
+package list
+
+// minDequeCapacity is the capacity of a deque's buffer after its first push.
+const minDequeCapacity = 8
+
+// Deque represents a double-ended queue backed by a ring buffer. Pushing and popping at
+// either end takes amortized O(1) and only allocates when the buffer has to grow.
+// The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+// NewDeque creates a new empty deque with room for capacity values before it grows.
+func NewDeque[T any](capacity int) *Deque[T] {
+	return &Deque[T]{
+		buf: make([]T, max(capacity, 0)),
+	}
+}
+
+// PushFront adds a value to the front of the deque.
+func (d *Deque[T]) PushFront(val T) {
+	d.grow()
+	d.head = d.index(-1)
+	d.buf[d.head] = val
+	d.size++
+}
+
+// PushBack adds a value to the back of the deque.
+func (d *Deque[T]) PushBack(val T) {
+	d.grow()
+	d.buf[d.index(d.size)] = val
+	d.size++
+}
+
+// PopFront removes and returns the value at the front of the deque.
+// Returns the value and true if the deque was not empty.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	val := d.buf[d.head]
+	// Clear the slot, so the buffer doesn't keep the value alive
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	return val, true
+}
+
+// PopBack removes and returns the value at the back of the deque.
+// Returns the value and true if the deque was not empty.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	i := d.index(d.size - 1)
+	val := d.buf[i]
+	d.buf[i] = zero
+	d.size--
+	return val, true
+}
+
+// Front returns the value at the front of the deque without removing it.
+// Returns the value and true if the deque was not empty.
+func (d *Deque[T]) Front() (T, bool) {
+	return d.At(0)
+}
+
+// Back returns the value at the back of the deque without removing it.
+// Returns the value and true if the deque was not empty.
+func (d *Deque[T]) Back() (T, bool) {
+	return d.At(d.size - 1)
+}
+
+// At returns the value at the specified index, counted from the front, and true if the index is valid.
+// Returns zero value and false if the index is out of bounds.
+func (d *Deque[T]) At(index int) (T, bool) {
+	if index < 0 || index >= d.size {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(index)], true
+}
+
+// Rotate moves the last n values to the front of the deque, one at a time, like the
+// marbles of a circle turned clockwise. A negative n moves the first values to the back.
+func (d *Deque[T]) Rotate(n int) {
+	if d.size < 2 {
+		return
+	}
+
+	n %= d.size
+	if n < 0 {
+		n += d.size
+	}
+	if n == 0 {
+		return
+	}
+
+	// A full buffer is already a circle, only its start moves
+	if d.size == len(d.buf) {
+		d.head = d.index(-n)
+		return
+	}
+
+	// Otherwise move the values in the shorter direction
+	if n <= d.size/2 {
+		for i := 0; i < n; i++ {
+			val, _ := d.PopBack()
+			d.PushFront(val)
+		}
+		return
+	}
+	for i := 0; i < d.size-n; i++ {
+		val, _ := d.PopFront()
+		d.PushBack(val)
+	}
+}
+
+// Size returns the number of elements in the deque.
+func (d *Deque[T]) Size() int {
+	return d.size
+}
+
+// IsEmpty returns true if the deque is empty.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// ToSlice returns all values in the deque as a slice (from front to back).
+func (d *Deque[T]) ToSlice() []T {
+	result := make([]T, d.size)
+	for i := range result {
+		result[i] = d.buf[d.index(i)]
+	}
+	return result
+}
+
+// index returns the position in the buffer of the value at offset i from the front.
+func (d *Deque[T]) index(i int) int {
+	i = (d.head + i) % len(d.buf)
+	if i < 0 {
+		i += len(d.buf)
+	}
+	return i
+}
+
+// grow doubles the buffer if it is full, moving the front of the deque to the start.
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+
+	buf := make([]T, max(2*len(d.buf), minDequeCapacity))
+	for i := 0; i < d.size; i++ {
+		buf[i] = d.buf[d.index(i)]
+	}
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,187 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDeque_PushPop(t *testing.T) {
+	var d Deque[int]
+	// Mixing both ends wraps around the buffer and makes it grow
+	for i := 0; i < 20; i++ {
+		if i%2 == 0 {
+			d.PushBack(i)
+		} else {
+			d.PushFront(i)
+		}
+	}
+
+	want := []int{19, 17, 15, 13, 11, 9, 7, 5, 3, 1, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18}
+	if got := d.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("ToSlice() = %v, want %v", got, want)
+	}
+	if v, ok := d.At(10); !ok || v != 0 {
+		t.Errorf("At(10) = %d, %v, want 0, true", v, ok)
+	}
+	if _, ok := d.At(20); ok {
+		t.Errorf("At(20) ok = true, want false")
+	}
+
+	if v, ok := d.PopFront(); !ok || v != 19 {
+		t.Errorf("PopFront() = %d, %v, want 19, true", v, ok)
+	}
+	if v, ok := d.PopBack(); !ok || v != 18 {
+		t.Errorf("PopBack() = %d, %v, want 18, true", v, ok)
+	}
+	if v, ok := d.Front(); !ok || v != 17 {
+		t.Errorf("Front() = %d, %v, want 17, true", v, ok)
+	}
+	if v, ok := d.Back(); !ok || v != 16 {
+		t.Errorf("Back() = %d, %v, want 16, true", v, ok)
+	}
+	if d.Size() != 18 {
+		t.Errorf("Size() = %d, want 18", d.Size())
+	}
+
+	for !d.IsEmpty() {
+		d.PopBack()
+	}
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("PopFront() on an empty deque ok = true, want false")
+	}
+	if _, ok := d.Back(); ok {
+		t.Errorf("Back() on an empty deque ok = true, want false")
+	}
+}
+
+func TestDeque_Rotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		n        int
+		want     []int
+	}{
+		{name: "right", capacity: 8, n: 2, want: []int{4, 5, 1, 2, 3}},
+		{name: "left", capacity: 8, n: -2, want: []int{3, 4, 5, 1, 2}},
+		{name: "more than size", capacity: 8, n: 7, want: []int{4, 5, 1, 2, 3}},
+		{name: "full buffer", capacity: 5, n: 2, want: []int{4, 5, 1, 2, 3}},
+		{name: "full buffer left", capacity: 5, n: -1, want: []int{2, 3, 4, 5, 1}},
+		{name: "none", capacity: 8, n: 5, want: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDeque[int](tt.capacity)
+			for i := 1; i <= 5; i++ {
+				d.PushBack(i)
+			}
+			d.Rotate(tt.n)
+			if got := d.ToSlice(); !slices.Equal(got, tt.want) {
+				t.Errorf("Rotate(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFIFO(t *testing.T) {
+	var q FIFO[int]
+	for i := 1; i <= 3; i++ {
+		q.Push(i)
+	}
+	if got, want := q.ToSlice(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+	if v, ok := q.Pop(); !ok || v != 1 {
+		t.Errorf("Pop() = %d, %v, want 1, true", v, ok)
+	}
+	if q.Size() != 2 || q.IsEmpty() {
+		t.Errorf("Size(), IsEmpty() = %d, %v, want 2, false", q.Size(), q.IsEmpty())
+	}
+}
+
+func TestStack(t *testing.T) {
+	var s Stack[int]
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	if got, want := s.ToSlice(), []int{3, 2, 1}; !slices.Equal(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+	if v, ok := s.Pop(); !ok || v != 3 {
+		t.Errorf("Pop() = %d, %v, want 3, true", v, ok)
+	}
+	if s.Size() != 2 || s.IsEmpty() {
+		t.Errorf("Size(), IsEmpty() = %d, %v, want 2, false", s.Size(), s.IsEmpty())
+	}
+}
+
+// linkedQueue is the node-per-push queue FIFO and Stack used before they were built on Deque,
+// kept as the baseline of the benchmarks.
+type linkedQueue[T any] struct {
+	head, tail *node[T]
+}
+
+func (q *linkedQueue[T]) push(val T) {
+	n := &node[T]{value: val}
+	if q.tail == nil {
+		q.head, q.tail = n, n
+		return
+	}
+	q.tail.next = n
+	q.tail = n
+}
+
+func (q *linkedQueue[T]) pop() (T, bool) {
+	if q.head == nil {
+		var zero T
+		return zero, false
+	}
+	val := q.head.value
+	q.head = q.head.next
+	if q.head == nil {
+		q.tail = nil
+	}
+	return val, true
+}
+
+// The benchmarks keep a queue of about 100 values busy, like the frontier of a BFS.
+const benchmarkOps = 10_000
+
+func BenchmarkFIFO(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		var q FIFO[int]
+		for i := 0; i < benchmarkOps; i++ {
+			q.Push(i)
+			if i >= 100 {
+				q.Pop()
+			}
+		}
+	}
+}
+
+func BenchmarkStack(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		var s Stack[int]
+		for i := 0; i < benchmarkOps; i++ {
+			s.Push(i)
+			if i >= 100 {
+				s.Pop()
+			}
+		}
+	}
+}
+
+func BenchmarkLinkedQueue(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		var q linkedQueue[int]
+		for i := 0; i < benchmarkOps; i++ {
+			q.push(i)
+			if i >= 100 {
+				q.pop()
+			}
+		}
+	}
+}
@@ -1,6 +1,6 @@
 package list
 
-// node represents a single-linked node for use in FIFO, Stack, and LinkedList.
+// node represents a single-linked node for use in LinkedList and SortedList.
 type node[T any] struct {
 	value T
 	next  *node[T]
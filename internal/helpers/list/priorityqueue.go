@@ -0,0 +1,161 @@
+package list
+
+// QueueItem is a handle to a value in a PriorityQueue, used to update or remove it.
+type QueueItem[T any] struct {
+	value T
+	// index is the position in the heap, or -1 once the item has left the queue.
+	index int
+}
+
+// Value returns the value of the item.
+func (it *QueueItem[T]) Value() T {
+	return it.value
+}
+
+// PriorityQueue represents a binary heap that pops the smallest value first according to
+// its comparison function. Push, Pop, Update and Remove take O(log n).
+type PriorityQueue[T any] struct {
+	items    []*QueueItem[T]
+	lessThan func(T, T) bool
+}
+
+// NewPriorityQueue creates a new priority queue that pops the smallest value first.
+// lessThan should return true if the first argument is less than the second.
+func NewPriorityQueue[T any](lessThan func(T, T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		lessThan: lessThan,
+	}
+}
+
+// NewMaxPriorityQueue creates a new priority queue that pops the largest value first.
+// lessThan should return true if the first argument is less than the second.
+func NewMaxPriorityQueue[T any](lessThan func(T, T) bool) *PriorityQueue[T] {
+	return NewPriorityQueue(func(a, b T) bool { return lessThan(b, a) })
+}
+
+// Push adds a value to the queue and returns its handle.
+func (q *PriorityQueue[T]) Push(val T) *QueueItem[T] {
+	item := &QueueItem[T]{value: val, index: len(q.items)}
+	q.items = append(q.items, item)
+	q.up(item.index)
+	return item
+}
+
+// Pop removes and returns the value at the front of the queue.
+// Returns the value and true if the queue was not empty.
+func (q *PriorityQueue[T]) Pop() (T, bool) {
+	if len(q.items) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	item := q.items[0]
+	q.removeAt(0)
+	return item.value, true
+}
+
+// Peek returns the value at the front of the queue without removing it.
+// Returns the value and true if the queue was not empty.
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	if len(q.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.items[0].value, true
+}
+
+// Update changes the value of an item and moves it to its new position, e.g. to decrease
+// the distance of a node in Dijkstra's algorithm.
+// Returns true if the item was still in the queue.
+func (q *PriorityQueue[T]) Update(item *QueueItem[T], val T) bool {
+	if !q.Contains(item) {
+		return false
+	}
+
+	item.value = val
+	if !q.up(item.index) {
+		q.down(item.index)
+	}
+	return true
+}
+
+// Remove removes an item from the queue.
+// Returns true if the item was still in the queue.
+func (q *PriorityQueue[T]) Remove(item *QueueItem[T]) bool {
+	if !q.Contains(item) {
+		return false
+	}
+
+	q.removeAt(item.index)
+	return true
+}
+
+// Contains returns true if the item has not been popped or removed yet.
+func (q *PriorityQueue[T]) Contains(item *QueueItem[T]) bool {
+	return item != nil && item.index >= 0 && item.index < len(q.items) && q.items[item.index] == item
+}
+
+// Size returns the number of elements in the queue.
+func (q *PriorityQueue[T]) Size() int {
+	return len(q.items)
+}
+
+// IsEmpty returns true if the queue is empty.
+func (q *PriorityQueue[T]) IsEmpty() bool {
+	return len(q.items) == 0
+}
+
+// removeAt removes the item at index i by moving the last item into its place.
+func (q *PriorityQueue[T]) removeAt(i int) {
+	removed := q.items[i]
+	last := len(q.items) - 1
+	if i != last {
+		q.swap(i, last)
+	}
+	q.items[last] = nil
+	q.items = q.items[:last]
+	removed.index = -1
+
+	if i != last && !q.up(i) {
+		q.down(i)
+	}
+}
+
+// up moves the item at index i towards the root until its parent is smaller.
+// Returns true if the item moved.
+func (q *PriorityQueue[T]) up(i int) bool {
+	start := i
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.lessThan(q.items[i].value, q.items[parent].value) {
+			break
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+	return i != start
+}
+
+// down moves the item at index i towards the leaves until both children are larger.
+func (q *PriorityQueue[T]) down(i int) {
+	n := len(q.items)
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < n && q.lessThan(q.items[child].value, q.items[smallest].value) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		q.swap(i, smallest)
+		i = smallest
+	}
+}
+
+func (q *PriorityQueue[T]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.items[i].index = i
+	q.items[j].index = j
+}
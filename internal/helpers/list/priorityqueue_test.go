@@ -0,0 +1,125 @@
+package list
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+func TestPriorityQueue_PushPop(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	values := r.Perm(100)
+
+	q := NewPriorityQueue(intLess)
+	for _, v := range values {
+		q.Push(v)
+	}
+	if q.Size() != len(values) {
+		t.Fatalf("Size() = %d, want %d", q.Size(), len(values))
+	}
+	if v, ok := q.Peek(); !ok || v != 0 {
+		t.Errorf("Peek() = %d, %v, want 0, true", v, ok)
+	}
+
+	sort.Ints(values)
+	for i, want := range values {
+		if got, ok := q.Pop(); !ok || got != want {
+			t.Fatalf("Pop() #%d = %d, %v, want %d, true", i, got, ok, want)
+		}
+	}
+	if _, ok := q.Pop(); ok || !q.IsEmpty() {
+		t.Errorf("Pop() on an empty queue ok = %v, want false", ok)
+	}
+}
+
+func TestPriorityQueue_Max(t *testing.T) {
+	q := NewMaxPriorityQueue(intLess)
+	for _, v := range []int{3, 1, 4, 1, 5} {
+		q.Push(v)
+	}
+
+	var got []int
+	for !q.IsEmpty() {
+		v, _ := q.Pop()
+		got = append(got, v)
+	}
+	if want := []int{5, 4, 3, 1, 1}; !slices.Equal(got, want) {
+		t.Errorf("Pop() order = %v, want %v", got, want)
+	}
+}
+
+func TestPriorityQueue_UpdateRemove(t *testing.T) {
+	q := NewPriorityQueue(intLess)
+	items := make(map[int]*QueueItem[int])
+	for _, v := range []int{10, 20, 30, 40, 50} {
+		items[v] = q.Push(v)
+	}
+
+	// Decrease-key moves 40 to the front, increasing 10 moves it to the back
+	if !q.Update(items[40], 5) {
+		t.Fatalf("Update() = false, want true")
+	}
+	if !q.Update(items[10], 60) {
+		t.Fatalf("Update() = false, want true")
+	}
+	if !q.Remove(items[30]) {
+		t.Fatalf("Remove() = false, want true")
+	}
+	if q.Remove(items[30]) || q.Contains(items[30]) {
+		t.Errorf("Remove() of a removed item = true, want false")
+	}
+
+	var got []int
+	for !q.IsEmpty() {
+		v, _ := q.Pop()
+		got = append(got, v)
+	}
+	if want := []int{5, 20, 50, 60}; !slices.Equal(got, want) {
+		t.Errorf("Pop() order = %v, want %v", got, want)
+	}
+
+	if q.Update(items[20], 1) {
+		t.Errorf("Update() of a popped item = true, want false")
+	}
+	if items[20].Value() != 20 {
+		t.Errorf("Value() = %d after failed Update(), want 20", items[20].Value())
+	}
+}
+
+// benchmarkValues are the priorities of the benchmarks, in random order as in a typical search.
+func benchmarkValues(n int) []int {
+	return rand.New(rand.NewSource(1)).Perm(n)
+}
+
+func BenchmarkPriorityQueue(b *testing.B) {
+	values := benchmarkValues(10_000)
+	b.ReportAllocs()
+	for b.Loop() {
+		q := NewPriorityQueue(intLess)
+		for _, v := range values {
+			q.Push(v)
+		}
+		for !q.IsEmpty() {
+			q.Pop()
+		}
+	}
+}
+
+func BenchmarkSortedList(b *testing.B) {
+	values := benchmarkValues(10_000)
+	b.ReportAllocs()
+	for b.Loop() {
+		l := NewSortedList(intLess)
+		for _, v := range values {
+			l.Insert(v)
+		}
+		// SortedList has no Pop, removing the head is the closest to it
+		for !l.IsEmpty() {
+			l.head = l.head.next
+			l.size--
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package search
+
+import "github.com/frederik-suerig/advent-of-code/internal/helpers/grid"
+
+// GridNeighbors returns the four cardinal neighbors of a point in g as edges of cost 1,
+// leaving out cells for which wall returns true.
+func GridNeighbors[T any](g *grid.Grid[T], wall func(T) bool) Neighbors[grid.Point] {
+	return func(p grid.Point) []Edge[grid.Point] {
+		var edges []Edge[grid.Point]
+		for _, n := range g.Neighbors4(p) {
+			if v, _ := g.Get(n); wall(v) {
+				continue
+			}
+			edges = append(edges, Edge[grid.Point]{To: n, Cost: 1})
+		}
+		return edges
+	}
+}
+
+// Manhattan returns an AStar heuristic for grids that can only be walked in the four
+// cardinal directions with a cost of at least 1 per step.
+func Manhattan(goal grid.Point) func(grid.Point) int {
+	return goal.ManhattanDistance
+}
@@ -0,0 +1,60 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/frederik-suerig/advent-of-code/internal/helpers/grid"
+)
+
+func TestGridNeighbors(t *testing.T) {
+	maze, err := grid.ParseStringGrid([]string{
+		"S..#....",
+		".#.#.##.",
+		".#...#..",
+		".####.#.",
+		"......#E",
+	})
+	if err != nil {
+		t.Fatalf("ParseStringGrid() error = %v", err)
+	}
+	start, _ := maze.Find(func(_ grid.Point, r rune) bool { return r == 'S' })
+	end, _ := maze.Find(func(_ grid.Point, r rune) bool { return r == 'E' })
+	neighbors := GridNeighbors(maze, func(r rune) bool { return r == '#' })
+
+	tests := []struct {
+		name   string
+		search func() *Result[grid.Point]
+	}{
+		{"BFS", func() *Result[grid.Point] { return BFS(start, neighbors, Reached(end)) }},
+		{"Dijkstra", func() *Result[grid.Point] { return Dijkstra(start, neighbors, Reached(end)) }},
+		{"AStar", func() *Result[grid.Point] { return AStar(start, neighbors, Reached(end), Manhattan(end)) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.search()
+			if d, ok := res.Distance(end); !ok || d != 15 {
+				t.Errorf("Distance(end) = %d, %v, want 15, true", d, ok)
+			}
+
+			path := res.Path(end)
+			if len(path) != 16 || path[0] != start || path[len(path)-1] != end {
+				t.Fatalf("Path(end) = %v, want 16 points from %v to %v", path, start, end)
+			}
+			for i, p := range path {
+				if v, _ := maze.Get(p); v == '#' {
+					t.Errorf("Path(end) walks through the wall at %v", p)
+				}
+				if i > 0 && p.ManhattanDistance(path[i-1]) != 1 {
+					t.Errorf("Path(end) jumps from %v to %v", path[i-1], p)
+				}
+			}
+		})
+	}
+
+	// Walled in
+	res := BFS(grid.Point{X: 0, Y: 0}, GridNeighbors(maze, func(r rune) bool { return r != 'S' }), nil)
+	if len(res.Dist) != 1 {
+		t.Errorf("BFS() from a walled-in cell reached %d cells, want 1", len(res.Dist))
+	}
+}
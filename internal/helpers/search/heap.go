@@ -0,0 +1,63 @@
+package search
+
+// heap is a binary min-heap ordered by less.
+type heap[T any] struct {
+	items []T
+	less  func(T, T) bool
+}
+
+func newHeap[T any](less func(T, T) bool) *heap[T] {
+	return &heap[T]{less: less}
+}
+
+func (h *heap[T]) len() int {
+	return len(h.items)
+}
+
+// push adds a value in O(log n).
+func (h *heap[T]) push(val T) {
+	h.items = append(h.items, val)
+	h.up(len(h.items) - 1)
+}
+
+// pop removes and returns the smallest value in O(log n). The heap must not be empty.
+func (h *heap[T]) pop() T {
+	top := h.items[0]
+	last := len(h.items) - 1
+	h.items[0] = h.items[last]
+	var zero T
+	h.items[last] = zero
+	h.items = h.items[:last]
+	if last > 0 {
+		h.down(0)
+	}
+	return top
+}
+
+func (h *heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i], h.items[parent]) {
+			return
+		}
+		h.items[i], h.items[parent] = h.items[parent], h.items[i]
+		i = parent
+	}
+}
+
+func (h *heap[T]) down(i int) {
+	n := len(h.items)
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < n && h.less(h.items[child], h.items[smallest]) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		h.items[i], h.items[smallest] = h.items[smallest], h.items[i]
+		i = smallest
+	}
+}
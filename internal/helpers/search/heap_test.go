@@ -0,0 +1,33 @@
+package search
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestHeap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = r.Intn(50)
+	}
+
+	h := newHeap(func(a, b int) bool { return a < b })
+	for _, v := range values {
+		h.push(v)
+	}
+	if h.len() != len(values) {
+		t.Fatalf("len() = %d, want %d", h.len(), len(values))
+	}
+
+	sort.Ints(values)
+	for i, want := range values {
+		if got := h.pop(); got != want {
+			t.Fatalf("pop() #%d = %d, want %d", i, got, want)
+		}
+	}
+	if h.len() != 0 {
+		t.Errorf("len() = %d after popping everything, want 0", h.len())
+	}
+}
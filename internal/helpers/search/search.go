@@ -0,0 +1,150 @@
+// Package search finds shortest paths in graphs given by a neighbors callback, so the
+// same code works for grids, state machines and any other graph of comparable states.
+package search
+
+// Edge is a transition to a neighboring state.
+type Edge[S comparable] struct {
+	To S
+	// Cost is the cost of the transition. BFS ignores it and counts every edge as 1,
+	// Dijkstra and AStar require it to be non-negative.
+	Cost int
+}
+
+// Neighbors returns the edges leaving a state.
+type Neighbors[S comparable] func(S) []Edge[S]
+
+// Result holds the outcome of a search.
+type Result[S comparable] struct {
+	// Dist is the distance from the start to every state that has been reached. If the search
+	// stopped at a goal, distances of states that were reached but not explored yet may be
+	// longer than their shortest distance; the distance of the goal and of every state on
+	// the path to it are final.
+	Dist map[S]int
+	// Prev is the predecessor of every reached state on a shortest path, except the start.
+	Prev map[S]S
+	// Goal is the first state the goal function accepted, if Found is true.
+	Goal  S
+	Found bool
+}
+
+// Reached returns a goal function accepting only target.
+func Reached[S comparable](target S) func(S) bool {
+	return func(s S) bool { return s == target }
+}
+
+func newResult[S comparable](start S) *Result[S] {
+	return &Result[S]{
+		Dist: map[S]int{start: 0},
+		Prev: make(map[S]S),
+	}
+}
+
+// Distance returns the distance from the start to a state and true if the state was reached.
+func (r *Result[S]) Distance(s S) (int, bool) {
+	d, ok := r.Dist[s]
+	return d, ok
+}
+
+// Path returns the states of a shortest path from the start to a state, both included.
+// Returns nil if the state was not reached.
+func (r *Result[S]) Path(to S) []S {
+	if _, ok := r.Dist[to]; !ok {
+		return nil
+	}
+
+	path := []S{to}
+	for {
+		prev, ok := r.Prev[to]
+		if !ok {
+			break
+		}
+		path = append(path, prev)
+		to = prev
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// BFS searches breadth-first from start, counting every edge as 1, until goal accepts a
+// state. A nil goal explores every reachable state.
+func BFS[S comparable](start S, neighbors Neighbors[S], goal func(S) bool) *Result[S] {
+	res := newResult(start)
+	queue := []S{start}
+
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+
+		if goal != nil && goal(curr) {
+			res.Goal, res.Found = curr, true
+			return res
+		}
+
+		for _, e := range neighbors(curr) {
+			if _, seen := res.Dist[e.To]; seen {
+				continue
+			}
+			res.Dist[e.To] = res.Dist[curr] + 1
+			res.Prev[e.To] = curr
+			queue = append(queue, e.To)
+		}
+	}
+	return res
+}
+
+// Dijkstra searches from start in order of increasing distance until goal accepts a state.
+// A nil goal explores every reachable state.
+func Dijkstra[S comparable](start S, neighbors Neighbors[S], goal func(S) bool) *Result[S] {
+	return AStar(start, neighbors, goal, nil)
+}
+
+// AStar searches from start like Dijkstra, but explores states closer to the goal first
+// according to heuristic. The heuristic must never overestimate the remaining distance,
+// otherwise the path found may not be the shortest. A state is explored again whenever a
+// shorter path to it turns up, which only happens if the heuristic is not also consistent,
+// i.e. h(s) <= cost(s, t) + h(t) for every edge. A nil heuristic is the same as Dijkstra.
+func AStar[S comparable](start S, neighbors Neighbors[S], goal func(S) bool, heuristic func(S) int) *Result[S] {
+	if heuristic == nil {
+		heuristic = func(S) int { return 0 }
+	}
+
+	res := newResult(start)
+	open := newHeap[item[S]](func(a, b item[S]) bool { return a.priority < b.priority })
+	open.push(item[S]{state: start, priority: heuristic(start)})
+
+	for open.len() > 0 {
+		it := open.pop()
+		curr := it.state
+		// A state is pushed again whenever a shorter path to it is found, older entries are stale
+		if it.dist > res.Dist[curr] {
+			continue
+		}
+
+		if goal != nil && goal(curr) {
+			res.Goal, res.Found = curr, true
+			return res
+		}
+
+		for _, e := range neighbors(curr) {
+			dist := res.Dist[curr] + e.Cost
+			if known, ok := res.Dist[e.To]; ok && known <= dist {
+				continue
+			}
+			res.Dist[e.To] = dist
+			res.Prev[e.To] = curr
+			open.push(item[S]{state: e.To, dist: dist, priority: dist + heuristic(e.To)})
+		}
+	}
+	return res
+}
+
+// item is a state in the open set of AStar.
+type item[S comparable] struct {
+	state S
+	// dist is the distance of the state when it was pushed.
+	dist     int
+	priority int
+}
@@ -0,0 +1,90 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+// graph is a small weighted graph where the direct edge a->d is more expensive than a->b->c->d.
+var graph = map[string][]Edge[string]{
+	"a": {{To: "b", Cost: 1}, {To: "d", Cost: 10}},
+	"b": {{To: "c", Cost: 2}},
+	"c": {{To: "d", Cost: 3}},
+	"d": {{To: "e", Cost: 1}},
+	"x": {{To: "a", Cost: 1}},
+}
+
+func graphNeighbors(s string) []Edge[string] {
+	return graph[s]
+}
+
+func TestBFS(t *testing.T) {
+	res := BFS("a", graphNeighbors, Reached("e"))
+	if !res.Found || res.Goal != "e" {
+		t.Fatalf("BFS() found = %v, goal = %q, want true, %q", res.Found, res.Goal, "e")
+	}
+	if d, _ := res.Distance("e"); d != 2 {
+		t.Errorf("Distance(e) = %d, want 2", d)
+	}
+	if got, want := res.Path("e"), []string{"a", "d", "e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path(e) = %v, want %v", got, want)
+	}
+
+	res = BFS("a", graphNeighbors, nil)
+	if res.Found {
+		t.Errorf("BFS() without goal found = true, want false")
+	}
+	if len(res.Dist) != 5 {
+		t.Errorf("BFS() without goal reached %d states, want 5", len(res.Dist))
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	res := Dijkstra("a", graphNeighbors, Reached("e"))
+	if !res.Found {
+		t.Fatalf("Dijkstra() found = false, want true")
+	}
+	if d, _ := res.Distance("e"); d != 7 {
+		t.Errorf("Distance(e) = %d, want 7", d)
+	}
+	if got, want := res.Path("e"), []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path(e) = %v, want %v", got, want)
+	}
+
+	res = Dijkstra("a", graphNeighbors, Reached("x"))
+	if res.Found {
+		t.Errorf("Dijkstra() found unreachable state")
+	}
+	if _, ok := res.Distance("x"); ok {
+		t.Errorf("Distance(x) ok = true, want false")
+	}
+	if path := res.Path("x"); path != nil {
+		t.Errorf("Path(x) = %v, want nil", path)
+	}
+}
+
+func TestResult_PathToStart(t *testing.T) {
+	res := Dijkstra("a", graphNeighbors, Reached("a"))
+	if got, want := res.Path("a"), []string{"a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path(a) = %v, want %v", got, want)
+	}
+}
+
+func TestAStar_InconsistentHeuristic(t *testing.T) {
+	// The heuristic never overestimates, but it makes a look more promising than b, so a
+	// is explored before the shorter path to it through b is known.
+	g := map[string][]Edge[string]{
+		"s": {{To: "a", Cost: 4}, {To: "b", Cost: 1}},
+		"b": {{To: "a", Cost: 1}},
+		"a": {{To: "g", Cost: 5}},
+	}
+	h := map[string]int{"b": 4}
+
+	res := AStar("s", func(s string) []Edge[string] { return g[s] }, Reached("g"), func(s string) int { return h[s] })
+	if d, _ := res.Distance("g"); d != 7 {
+		t.Errorf("Distance(g) = %d, want 7", d)
+	}
+	if got, want := res.Path("g"), []string{"s", "b", "a", "g"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Path(g) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,25 @@
+package unionfind
+
+import "github.com/frederik-suerig/advent-of-code/internal/helpers/grid"
+
+// FromGrid creates a union-find of the points of g in which cells are in the same set if
+// they hold the same value and are connected through cardinal neighbors with that value,
+// e.g. the garden regions of a map of plants.
+func FromGrid[T comparable](g *grid.Grid[T]) *UnionFind[grid.Point] {
+	u := NewUnionFind[grid.Point]()
+	// Add the points first, so the components are in reading order
+	g.ForEach(func(p grid.Point, _ T) bool {
+		u.Add(p)
+		return true
+	})
+
+	g.ForEach(func(p grid.Point, v T) bool {
+		for _, n := range g.Neighbors4(p) {
+			if w, _ := g.Get(n); w == v {
+				u.Union(p, n)
+			}
+		}
+		return true
+	})
+	return u
+}
@@ -0,0 +1,37 @@
+package unionfind
+
+import (
+	"testing"
+
+	"github.com/frederik-suerig/advent-of-code/internal/helpers/grid"
+)
+
+func TestFromGrid(t *testing.T) {
+	// The garden of Advent of Code 2024 day 12, where the O region surrounds two X regions
+	g, err := grid.ParseStringGrid([]string{
+		"OOOOO",
+		"OXOXO",
+		"OOOOO",
+		"OXOXO",
+		"OOOOO",
+	})
+	if err != nil {
+		t.Fatalf("ParseStringGrid() error = %v", err)
+	}
+
+	u := FromGrid(g)
+	if u.Count() != 5 {
+		t.Errorf("Count() = %d, want 5", u.Count())
+	}
+	if u.Size(grid.Point{X: 0, Y: 0}) != 21 {
+		t.Errorf("Size() of the O region = %d, want 21", u.Size(grid.Point{X: 0, Y: 0}))
+	}
+	if u.Connected(grid.Point{X: 1, Y: 1}, grid.Point{X: 3, Y: 1}) {
+		t.Errorf("Connected() = true for two separate X regions")
+	}
+
+	components := u.Components()
+	if len(components) != 5 || components[0][0] != (grid.Point{X: 0, Y: 0}) || components[1][0] != (grid.Point{X: 1, Y: 1}) {
+		t.Errorf("Components() are not in reading order: %v", components)
+	}
+}
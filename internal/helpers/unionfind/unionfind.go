@@ -0,0 +1,131 @@
+// Package unionfind groups values into disjoint sets, e.g. connected regions or the
+// clusters of Kruskal's algorithm.
+package unionfind
+
+// UnionFind represents a collection of disjoint sets of values. Find and Union take
+// nearly O(1) thanks to path compression and union by size.
+type UnionFind[T comparable] struct {
+	ids    map[T]int
+	values []T
+	parent []int
+	size   []int
+	count  int
+}
+
+// NewUnionFind creates a new union-find with a set for each of the given values.
+func NewUnionFind[T comparable](values ...T) *UnionFind[T] {
+	u := &UnionFind[T]{
+		ids: make(map[T]int, len(values)),
+	}
+	for _, v := range values {
+		u.Add(v)
+	}
+	return u
+}
+
+// Add adds a value as a set of its own.
+// Returns true if the value was not already present.
+func (u *UnionFind[T]) Add(val T) bool {
+	if _, ok := u.ids[val]; ok {
+		return false
+	}
+
+	u.ids[val] = len(u.values)
+	u.values = append(u.values, val)
+	u.parent = append(u.parent, len(u.parent))
+	u.size = append(u.size, 1)
+	u.count++
+	return true
+}
+
+// Find returns the representative of the set containing a value. Values in the same set
+// have the same representative. A value that is not present is added as a set of its own.
+func (u *UnionFind[T]) Find(val T) T {
+	return u.values[u.root(u.id(val))]
+}
+
+// Union merges the sets containing a and b, adding values that are not present.
+// Returns true if they were in different sets.
+func (u *UnionFind[T]) Union(a, b T) bool {
+	ra, rb := u.root(u.id(a)), u.root(u.id(b))
+	if ra == rb {
+		return false
+	}
+
+	// Attach the smaller tree to the larger one, so the trees stay flat
+	if u.size[ra] < u.size[rb] {
+		ra, rb = rb, ra
+	}
+	u.parent[rb] = ra
+	u.size[ra] += u.size[rb]
+	u.count--
+	return true
+}
+
+// Connected returns true if a and b are in the same set.
+// Values that are not present are only connected to themselves.
+func (u *UnionFind[T]) Connected(a, b T) bool {
+	ia, okA := u.ids[a]
+	ib, okB := u.ids[b]
+	if !okA || !okB {
+		return a == b
+	}
+	return u.root(ia) == u.root(ib)
+}
+
+// Size returns the number of values in the set containing a value, or 0 if it is not present.
+func (u *UnionFind[T]) Size(val T) int {
+	id, ok := u.ids[val]
+	if !ok {
+		return 0
+	}
+	return u.size[u.root(id)]
+}
+
+// Count returns the number of sets.
+func (u *UnionFind[T]) Count() int {
+	return u.count
+}
+
+// Len returns the number of values.
+func (u *UnionFind[T]) Len() int {
+	return len(u.values)
+}
+
+// Components returns the values of every set. The sets are ordered by the value of
+// each set that was added first, and the values of a set in the order they were added.
+func (u *UnionFind[T]) Components() [][]T {
+	groups := make(map[int]int, u.count)
+	components := make([][]T, 0, u.count)
+	for id, val := range u.values {
+		root := u.root(id)
+		i, ok := groups[root]
+		if !ok {
+			i = len(components)
+			groups[root] = i
+			components = append(components, make([]T, 0, u.size[root]))
+		}
+		components[i] = append(components[i], val)
+	}
+	return components
+}
+
+// id returns the index of a value, adding it if it is not present.
+func (u *UnionFind[T]) id(val T) int {
+	u.Add(val)
+	return u.ids[val]
+}
+
+// root returns the root of the tree containing id and points every node on the way to it.
+func (u *UnionFind[T]) root(id int) int {
+	root := id
+	for u.parent[root] != root {
+		root = u.parent[root]
+	}
+	for id != root {
+		next := u.parent[id]
+		u.parent[id] = root
+		id = next
+	}
+	return root
+}
@@ -0,0 +1,59 @@
+package unionfind
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnionFind(t *testing.T) {
+	u := NewUnionFind("a", "b", "c", "d", "e")
+	if u.Count() != 5 || u.Len() != 5 {
+		t.Fatalf("Count(), Len() = %d, %d, want 5, 5", u.Count(), u.Len())
+	}
+
+	if !u.Union("a", "b") || !u.Union("c", "d") || !u.Union("b", "d") {
+		t.Fatalf("Union() of different sets = false, want true")
+	}
+	if u.Union("a", "c") {
+		t.Errorf("Union() of the same set = true, want false")
+	}
+
+	if !u.Connected("a", "d") {
+		t.Errorf("Connected(a, d) = false, want true")
+	}
+	if u.Connected("a", "e") {
+		t.Errorf("Connected(a, e) = true, want false")
+	}
+	if u.Find("a") != u.Find("d") {
+		t.Errorf("Find(a) = %q, Find(d) = %q, want the same", u.Find("a"), u.Find("d"))
+	}
+	if u.Size("c") != 4 || u.Size("e") != 1 || u.Size("z") != 0 {
+		t.Errorf("Size(c), Size(e), Size(z) = %d, %d, %d, want 4, 1, 0", u.Size("c"), u.Size("e"), u.Size("z"))
+	}
+	if u.Count() != 2 {
+		t.Errorf("Count() = %d, want 2", u.Count())
+	}
+
+	want := [][]string{{"a", "b", "c", "d"}, {"e"}}
+	if got := u.Components(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Components() = %v, want %v", got, want)
+	}
+
+	// Unknown values are added on the fly
+	if !u.Union("f", "g") || u.Count() != 3 || u.Len() != 7 {
+		t.Errorf("Union(f, g) gave Count(), Len() = %d, %d, want 3, 7", u.Count(), u.Len())
+	}
+	if u.Connected("x", "y") || !u.Connected("x", "x") {
+		t.Errorf("Connected() of unknown values is wrong")
+	}
+}
+
+func TestUnionFind_LongChain(t *testing.T) {
+	u := NewUnionFind[int]()
+	for i := 1; i < 10_000; i++ {
+		u.Union(i-1, i)
+	}
+	if u.Count() != 1 || u.Size(0) != 10_000 {
+		t.Errorf("Count(), Size(0) = %d, %d, want 1, 10000", u.Count(), u.Size(0))
+	}
+}
@@ -0,0 +1,103 @@
+package leaderboard
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// StarKey identifies the star of a part
+type StarKey struct {
+	Day  int
+	Part int
+}
+
+func (k StarKey) String() string {
+	return fmt.Sprintf("d%02dp%d", k.Day, k.Part)
+}
+
+// Change describes how a member changed between two snapshots
+type Change struct {
+	Name string
+	// Joined is true if the member was not on the previous snapshot.
+	Joined   bool
+	NewStars []StarKey
+	Score    int
+	OldRank  int
+	NewRank  int
+}
+
+func (c Change) String() string {
+	var parts []string
+	if c.Joined {
+		parts = append(parts, "joined")
+	}
+	if len(c.NewStars) > 0 {
+		stars := make([]string, len(c.NewStars))
+		for i, k := range c.NewStars {
+			stars[i] = k.String()
+		}
+		noun := "stars"
+		if len(stars) == 1 {
+			noun = "star"
+		}
+		parts = append(parts, fmt.Sprintf("earned %d %s (%s)", len(stars), noun, strings.Join(stars, ", ")))
+	}
+	if c.Score != 0 {
+		parts = append(parts, fmt.Sprintf("%+d points", c.Score))
+	}
+	if !c.Joined && c.OldRank != c.NewRank {
+		parts = append(parts, fmt.Sprintf("rank %d → %d", c.OldRank, c.NewRank))
+	}
+	return c.Name + " " + strings.Join(parts, ", ")
+}
+
+// Diff returns the changes of every member from prev to cur, ordered by the current ranking.
+// Members that did not change are left out.
+func Diff(prev, cur *Leaderboard) []Change {
+	oldRanks := ranks(prev)
+	newRanks := ranks(cur)
+
+	var changes []Change
+	for _, m := range cur.Ranking() {
+		old, existed := prev.Members[strconv.Itoa(m.ID)]
+
+		c := Change{
+			Name:    m.DisplayName(),
+			Joined:  !existed,
+			Score:   m.LocalScore - old.LocalScore,
+			OldRank: oldRanks[m.ID],
+			NewRank: newRanks[m.ID],
+		}
+		for day, parts := range m.CompletionDayLevel {
+			for part := range parts {
+				if _, ok := old.CompletionDayLevel[day][part]; !ok {
+					d, _ := strconv.Atoi(day)
+					p, _ := strconv.Atoi(part)
+					c.NewStars = append(c.NewStars, StarKey{Day: d, Part: p})
+				}
+			}
+		}
+		sort.Slice(c.NewStars, func(i, j int) bool {
+			if c.NewStars[i].Day != c.NewStars[j].Day {
+				return c.NewStars[i].Day < c.NewStars[j].Day
+			}
+			return c.NewStars[i].Part < c.NewStars[j].Part
+		})
+
+		if c.Joined || len(c.NewStars) > 0 || c.Score != 0 || c.OldRank != c.NewRank {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// ranks maps member IDs to their position in the ranking, starting at 1.
+func ranks(lb *Leaderboard) map[int]int {
+	r := make(map[int]int)
+	for i, m := range lb.Ranking() {
+		r[m.ID] = i + 1
+	}
+	return r
+}
@@ -0,0 +1,11 @@
+package leaderboard
+
+import "errors"
+
+// Domain-specific errors
+var (
+	ErrInvalidYear    = errors.New("invalid year")
+	ErrInvalidID      = errors.New("invalid leaderboard id")
+	ErrCookieRequired = errors.New("cookie is required")
+	ErrClientRequired = errors.New("client is required")
+)
@@ -0,0 +1,119 @@
+// Package leaderboard reads private leaderboards and tracks how they change between checks.
+package leaderboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+)
+
+// Leaderboard is a private leaderboard as returned by /{year}/leaderboard/private/view/{id}.json
+type Leaderboard struct {
+	Event   string            `json:"event"`
+	OwnerID int               `json:"owner_id"`
+	Members map[string]Member `json:"members"`
+}
+
+// Member is a member of a private leaderboard
+type Member struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Stars       int    `json:"stars"`
+	LocalScore  int    `json:"local_score"`
+	GlobalScore int    `json:"global_score"`
+	LastStarTS  int64  `json:"last_star_ts"`
+	// CompletionDayLevel maps days and parts to the stars earned, e.g. {"5": {"1": {...}}}
+	CompletionDayLevel map[string]map[string]Star `json:"completion_day_level"`
+}
+
+// Star is a star earned by a member
+type Star struct {
+	GetStarTS int64 `json:"get_star_ts"`
+	StarIndex int   `json:"star_index"`
+}
+
+// Parse decodes the JSON of a private leaderboard.
+func Parse(data []byte) (*Leaderboard, error) {
+	var lb Leaderboard
+	if err := json.Unmarshal(data, &lb); err != nil {
+		return nil, fmt.Errorf("failed to parse leaderboard: %w", err)
+	}
+	return &lb, nil
+}
+
+// Year returns the year of the event.
+func (lb *Leaderboard) Year() int {
+	year, _ := strconv.Atoi(lb.Event)
+	return year
+}
+
+// Ranking returns the members sorted by local score, then by stars and
+// then by who got their last star first.
+func (lb *Leaderboard) Ranking() []Member {
+	members := make([]Member, 0, len(lb.Members))
+	for _, m := range lb.Members {
+		members = append(members, m)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		a, b := members[i], members[j]
+		if a.LocalScore != b.LocalScore {
+			return a.LocalScore > b.LocalScore
+		}
+		if a.Stars != b.Stars {
+			return a.Stars > b.Stars
+		}
+		if a.LastStarTS != b.LastStarTS {
+			return a.LastStarTS < b.LastStarTS
+		}
+		return a.ID < b.ID
+	})
+	return members
+}
+
+// DisplayName returns the member's name, or a placeholder for anonymous members.
+func (m Member) DisplayName() string {
+	if m.Name == "" {
+		return fmt.Sprintf("(anonymous user #%d)", m.ID)
+	}
+	return m.Name
+}
+
+// StarTime returns when the member earned the star of a part and true if they did.
+func (m Member) StarTime(day, part int) (time.Time, bool) {
+	star, ok := m.CompletionDayLevel[strconv.Itoa(day)][strconv.Itoa(part)]
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(star.GetStarTS, 0), true
+}
+
+// StarCount returns how many stars the member earned on a day.
+func (m Member) StarCount(day int) int {
+	return len(m.CompletionDayLevel[strconv.Itoa(day)])
+}
+
+// Completion returns how long after the puzzle's unlock the member earned the star of a part.
+func (m Member) Completion(year, day, part int) (time.Duration, bool) {
+	at, ok := m.StarTime(day, part)
+	if !ok {
+		return 0, false
+	}
+	return at.Sub(aoc.UnlockTime(year, day)), true
+}
+
+// Delta returns how long the member took for part two after solving part one.
+func (m Member) Delta(day int) (time.Duration, bool) {
+	first, ok := m.StarTime(day, 1)
+	if !ok {
+		return 0, false
+	}
+	second, ok := m.StarTime(day, 2)
+	if !ok {
+		return 0, false
+	}
+	return second.Sub(first), true
+}
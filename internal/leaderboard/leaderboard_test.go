@@ -0,0 +1,101 @@
+package leaderboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+)
+
+// unlock5 is when day 5 of 2024 was released.
+var unlock5 = aoc.UnlockTime(2024, 5).Unix()
+
+func board(t *testing.T, json string) *Leaderboard {
+	t.Helper()
+	lb, err := Parse([]byte(json))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return lb
+}
+
+const before = `{"event":"2024","owner_id":1,"members":{
+	"1":{"id":1,"name":"alice","stars":2,"local_score":10,"last_star_ts":1733389000,
+		"completion_day_level":{"5":{"1":{"get_star_ts":1733375100},"2":{"get_star_ts":1733375400}}}},
+	"2":{"id":2,"name":null,"stars":1,"local_score":12,"last_star_ts":1733380000,
+		"completion_day_level":{"5":{"1":{"get_star_ts":1733380000}}}}
+}}`
+
+const after = `{"event":"2024","owner_id":1,"members":{
+	"1":{"id":1,"name":"alice","stars":2,"local_score":10,"last_star_ts":1733389000,
+		"completion_day_level":{"5":{"1":{"get_star_ts":1733375100},"2":{"get_star_ts":1733375400}}}},
+	"2":{"id":2,"name":null,"stars":3,"local_score":25,"last_star_ts":1733462000,
+		"completion_day_level":{"5":{"1":{"get_star_ts":1733380000},"2":{"get_star_ts":1733390000}},"6":{"1":{"get_star_ts":1733462000}}}},
+	"3":{"id":3,"name":"carol","stars":0,"local_score":0,"last_star_ts":0,"completion_day_level":{}}
+}}`
+
+func TestLeaderboard_Ranking(t *testing.T) {
+	lb := board(t, after)
+
+	ranking := lb.Ranking()
+	want := []string{"(anonymous user #2)", "alice", "carol"}
+	if len(ranking) != len(want) {
+		t.Fatalf("Ranking() has %d members, want %d", len(ranking), len(want))
+	}
+	for i, name := range want {
+		if got := ranking[i].DisplayName(); got != name {
+			t.Errorf("Ranking()[%d] = %q, want %q", i, got, name)
+		}
+	}
+}
+
+func TestMember_Completion(t *testing.T) {
+	alice := board(t, before).Members["1"]
+
+	tests := []struct {
+		name   string
+		part   int
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "part one", part: 1, want: time.Duration(1733375100-unlock5) * time.Second, wantOK: true},
+		{name: "part two", part: 2, want: time.Duration(1733375400-unlock5) * time.Second, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := alice.Completion(2024, 5, tt.part)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Completion() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	if delta, ok := alice.Delta(5); !ok || delta != 5*time.Minute {
+		t.Errorf("Delta() = %v, %v, want 5m0s, true", delta, ok)
+	}
+	if _, ok := alice.Delta(6); ok {
+		t.Errorf("Delta() of an unsolved day = true, want false")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	changes := Diff(board(t, before), board(t, after))
+
+	want := []string{
+		"(anonymous user #2) earned 2 stars (d05p2, d06p1), +13 points",
+		"carol joined",
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("Diff() = %v, want %v", changes, want)
+	}
+	for i := range want {
+		if got := changes[i].String(); got != want[i] {
+			t.Errorf("Diff()[%d] = %q, want %q", i, got, want[i])
+		}
+	}
+
+	if changes := Diff(board(t, after), board(t, after)); len(changes) != 0 {
+		t.Errorf("Diff() of equal leaderboards = %v, want none", changes)
+	}
+}
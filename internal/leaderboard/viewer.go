@@ -0,0 +1,106 @@
+package leaderboard
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+)
+
+// Config holds the configuration for viewing a private leaderboard
+type Config struct {
+	Year int
+	// ID is the leaderboard's ID, which is the user ID of its owner.
+	ID int
+	// Client downloads the leaderboard. It needs a session cookie.
+	Client *aoc.Client
+}
+
+// Report is the current state of a leaderboard compared to the last check
+type Report struct {
+	Board *Leaderboard
+	// Fetched is when the leaderboard was downloaded; it may be up to aoc.LeaderboardTTL old.
+	Fetched time.Time
+	// Previous is the snapshot of the last check, or nil on the first check.
+	Previous *Leaderboard
+	// PreviousChecked is when the snapshot of the last check was taken.
+	PreviousChecked time.Time
+	Changes         []Change
+}
+
+type Viewer struct {
+	year   int
+	id     int
+	client *aoc.Client
+}
+
+func NewViewer(cfg Config) (*Viewer, error) {
+	v := &Viewer{
+		year:   cfg.Year,
+		id:     cfg.ID,
+		client: cfg.Client,
+	}
+	if err := v.init(); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func (v *Viewer) init() error {
+	if v.year <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidYear, v.year)
+	}
+	if v.id <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidID, v.id)
+	}
+
+	if v.client == nil {
+		return ErrClientRequired
+	}
+	if !v.client.HasCookie() {
+		return ErrCookieRequired
+	}
+
+	return nil
+}
+
+// Check downloads the leaderboard (or reuses a download younger than aoc.LeaderboardTTL),
+// compares it to the snapshot of the last check and stores it as the new snapshot.
+func (v *Viewer) Check() (*Report, error) {
+	data, fetched, err := v.client.Leaderboard(v.year, v.id)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{Board: board, Fetched: fetched}
+
+	cache := v.client.Cache()
+	name := v.snapshotName()
+	if previous, ok := cache.Get(v.year, 0, name); ok {
+		report.PreviousChecked, _ = cache.Modified(v.year, 0, name)
+		if report.Previous, err = Parse(previous); err != nil {
+			return nil, fmt.Errorf("failed to read snapshot: %w", err)
+		}
+		report.Changes = Diff(report.Previous, board)
+
+		// An unchanged snapshot is not rewritten, so PreviousChecked is when the last change was seen
+		if bytes.Equal(previous, data) {
+			return report, nil
+		}
+	}
+
+	if err := cache.Put(v.year, 0, name, data); err != nil {
+		return nil, fmt.Errorf("failed to store snapshot: %w", err)
+	}
+	return report, nil
+}
+
+func (v *Viewer) snapshotName() string {
+	return fmt.Sprintf("leaderboard-%d-snapshot.json", v.id)
+}
@@ -0,0 +1,89 @@
+package leaderboard
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+)
+
+func TestViewer_Check(t *testing.T) {
+	current := before
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(current))
+	}))
+	defer server.Close()
+
+	cacheDir := t.TempDir()
+	client, err := aoc.NewClient(aoc.Config{BaseURL: server.URL, Cookie: "secret", CacheDir: cacheDir, MinInterval: -1})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	v, err := NewViewer(Config{Year: 2024, ID: 1, Client: client})
+	if err != nil {
+		t.Fatalf("NewViewer() error = %v", err)
+	}
+
+	report, err := v.Check()
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if report.Previous != nil || len(report.Changes) != 0 {
+		t.Errorf("first Check() = %+v, want no previous snapshot", report)
+	}
+
+	// The leaderboard changes, but the download is reused until it expires
+	current = after
+	if report, err = v.Check(); err != nil || len(report.Changes) != 0 {
+		t.Errorf("Check() within the TTL = %v, %v, want no changes", report.Changes, err)
+	}
+
+	expired := time.Now().Add(-aoc.LeaderboardTTL)
+	path := filepath.Join(cacheDir, "2024", "0", "leaderboard-1.json")
+	if err := os.Chtimes(path, expired, expired); err != nil {
+		t.Fatal(err)
+	}
+	if report, err = v.Check(); err != nil || len(report.Changes) != 2 {
+		t.Errorf("Check() after the TTL = %v, %v, want 2 changes", report.Changes, err)
+	}
+
+	// The snapshot now holds the latest leaderboard
+	if report, err = v.Check(); err != nil || len(report.Changes) != 0 {
+		t.Errorf("Check() again = %v, %v, want no changes", report.Changes, err)
+	}
+}
+
+func TestNewViewer(t *testing.T) {
+	withCookie, err := aoc.NewClient(aoc.Config{Cookie: "secret", CacheDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	withoutCookie, err := aoc.NewClient(aoc.Config{CacheDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr error
+	}{
+		{name: "valid", cfg: Config{Year: 2024, ID: 1, Client: withCookie}},
+		{name: "no id", cfg: Config{Year: 2024, Client: withCookie}, wantErr: ErrInvalidID},
+		{name: "no cookie", cfg: Config{Year: 2024, ID: 1, Client: withoutCookie}, wantErr: ErrCookieRequired},
+		{name: "no client", cfg: Config{Year: 2024, ID: 1}, wantErr: ErrClientRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewViewer(tt.cfg); !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewViewer() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Package lock turns verified answers into regression tests by filling in the
+// // Output: comment of a day's example functions.
+package lock
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+)
+
+// ErrExampleNotFound is returned when a test file has no example function of the given name.
+var ErrExampleNotFound = errors.New("example function not found")
+
+// ExampleName returns the name of the example function of a part, e.g. ExamplePartOne.
+func ExampleName(part int) string {
+	if part == 2 {
+		return "ExamplePartTwo"
+	}
+	return "ExamplePartOne"
+}
+
+// SetOutput sets the expected output of an example function in a Go test file,
+// replacing its // Output: comment or adding one at the end of the function.
+// The rest of the file is left as it is.
+func SetOutput(path, example, output string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	updated, err := setOutput(src, example, output)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, updated, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+func setOutput(src []byte, example, output string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse test file: %w", err)
+	}
+
+	fn := findFunc(file, example)
+	if fn == nil || fn.Body == nil {
+		return nil, fmt.Errorf("%w: %s", ErrExampleNotFound, example)
+	}
+
+	// The comment is spliced into the source and indented by gofmt
+	var start, end int
+	var comment string
+	if group := outputComment(file, fn); group != nil {
+		start = fset.Position(group.Pos()).Offset
+		end = fset.Position(group.End()).Offset
+		comment = outputLines(output)
+	} else {
+		start = fset.Position(fn.Body.Rbrace).Offset
+		end = start
+		comment = "\n" + outputLines(output) + "\n"
+	}
+
+	var buf bytes.Buffer
+	buf.Write(src[:start])
+	buf.WriteString(comment)
+	buf.Write(src[end:])
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format test file: %w", err)
+	}
+	return formatted, nil
+}
+
+func findFunc(file *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+// outputComment returns the comment group of fn holding its // Output: comment.
+// Like go test, only the last comment group of the function is considered.
+func outputComment(file *ast.File, fn *ast.FuncDecl) *ast.CommentGroup {
+	var last *ast.CommentGroup
+	for _, group := range file.Comments {
+		if group.Pos() > fn.Body.Lbrace && group.End() < fn.Body.Rbrace {
+			last = group
+		}
+	}
+	if last == nil {
+		return nil
+	}
+
+	text := strings.TrimSpace(last.Text())
+	if strings.HasPrefix(text, "Output:") || strings.HasPrefix(text, "Unordered output:") {
+		return last
+	}
+	return nil
+}
+
+// outputLines renders output as an // Output: comment. Outputs of several lines
+// continue on the following lines, as go test expects.
+func outputLines(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) == 1 {
+		return "// Output: " + lines[0]
+	}
+
+	var sb strings.Builder
+	sb.WriteString("// Output:")
+	for _, line := range lines {
+		sb.WriteString("\n// " + strings.TrimRight(line, " \t"))
+	}
+	return sb.String()
+}
@@ -0,0 +1,182 @@
+package lock
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testFile = `package d05
+
+import (
+	"log"
+	"os"
+)
+
+// ExamplePartOne checks part one against the input.
+func ExamplePartOne() {
+	file, err := os.Open("testdata/input.txt")
+	if err != nil {
+		log.Fatalf("could not open input file: %v", err)
+	}
+	defer file.Close()
+
+	// When challenge is solved, you can change the comment to the expected output.
+
+	// Output: 
+}
+
+func ExamplePartTwo() {
+	// Output: 12
+}
+
+func ExampleHelper() {
+	log.Print("no output")
+}
+`
+
+func TestSetOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		example string
+		output  string
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "fills empty output",
+			example: "ExamplePartOne",
+			output:  "4242\n",
+			want: `package d05
+
+import (
+	"log"
+	"os"
+)
+
+// ExamplePartOne checks part one against the input.
+func ExamplePartOne() {
+	file, err := os.Open("testdata/input.txt")
+	if err != nil {
+		log.Fatalf("could not open input file: %v", err)
+	}
+	defer file.Close()
+
+	// When challenge is solved, you can change the comment to the expected output.
+
+	// Output: 4242
+}
+
+func ExamplePartTwo() {
+	// Output: 12
+}
+
+func ExampleHelper() {
+	log.Print("no output")
+}
+`,
+		},
+		{
+			name:    "replaces output with several lines",
+			example: "ExamplePartTwo",
+			output:  "#.#\n.#.",
+			want: `package d05
+
+import (
+	"log"
+	"os"
+)
+
+// ExamplePartOne checks part one against the input.
+func ExamplePartOne() {
+	file, err := os.Open("testdata/input.txt")
+	if err != nil {
+		log.Fatalf("could not open input file: %v", err)
+	}
+	defer file.Close()
+
+	// When challenge is solved, you can change the comment to the expected output.
+
+	// Output:
+}
+
+func ExamplePartTwo() {
+	// Output:
+	// #.#
+	// .#.
+}
+
+func ExampleHelper() {
+	log.Print("no output")
+}
+`,
+		},
+		{
+			name:    "adds missing output",
+			example: "ExampleHelper",
+			output:  "7",
+			want: `package d05
+
+import (
+	"log"
+	"os"
+)
+
+// ExamplePartOne checks part one against the input.
+func ExamplePartOne() {
+	file, err := os.Open("testdata/input.txt")
+	if err != nil {
+		log.Fatalf("could not open input file: %v", err)
+	}
+	defer file.Close()
+
+	// When challenge is solved, you can change the comment to the expected output.
+
+	// Output:
+}
+
+func ExamplePartTwo() {
+	// Output: 12
+}
+
+func ExampleHelper() {
+	log.Print("no output")
+
+	// Output: 7
+}
+`,
+		},
+		{
+			name:    "unknown example",
+			example: "ExamplePartThree",
+			output:  "1",
+			wantErr: ErrExampleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "solution_test.go")
+			if err := os.WriteFile(path, []byte(testFile), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			err := SetOutput(path, tt.example, tt.output)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SetOutput() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("SetOutput() wrote\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
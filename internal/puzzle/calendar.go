@@ -0,0 +1,71 @@
+package puzzle
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// calendarDayRegex matches the class of a day on the event page, e.g. "calendar-day5".
+var calendarDayRegex = regexp.MustCompile(`^calendar-day(\d+)$`)
+
+// ParseStars returns the number of stars of each day shown on the calendar of an event
+// page, e.g. https://adventofcode.com/2024. Stars are only shown when logged in.
+func ParseStars(page io.Reader) (map[int]int, error) {
+	doc, err := html.Parse(page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse page: %w", err)
+	}
+
+	stars := make(map[int]int)
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "a" {
+			if day, ok := calendarDay(n); ok {
+				stars[day] = max(stars[day], starCount(n))
+				return
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	return stars, nil
+}
+
+func calendarDay(n *html.Node) (int, bool) {
+	for _, class := range strings.Fields(attr(n, "class")) {
+		if m := calendarDayRegex.FindStringSubmatch(class); m != nil {
+			day, err := strconv.Atoi(m[1])
+			return day, err == nil
+		}
+	}
+	return 0, false
+}
+
+// starCount reads the stars of a calendar day from its classes, falling back to its label,
+// e.g. aria-label="Day 5, two stars".
+func starCount(n *html.Node) int {
+	switch {
+	case hasClass(n, "calendar-verycomplete"):
+		return 2
+	case hasClass(n, "calendar-complete"):
+		return 1
+	}
+
+	label := strings.ToLower(attr(n, "aria-label"))
+	switch {
+	case strings.Contains(label, "two stars"):
+		return 2
+	case strings.Contains(label, "one star"):
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,41 @@
+package puzzle
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseStars(t *testing.T) {
+	page := `<html><body><main><pre class="calendar">
+<a aria-label="Day 1, two stars" href="/2024/day/1" class="calendar-day1 calendar-verycomplete">art <span class="calendar-day"> 1</span></a>
+<a aria-label="Day 2, one star" href="/2024/day/2" class="calendar-day2 calendar-complete">art <span class="calendar-day"> 2</span></a>
+<a aria-label="Day 3, two stars" href="/2024/day/3" class="calendar-day3">art <span class="calendar-day"> 3</span></a>
+<a aria-label="Day 4" href="/2024/day/4" class="calendar-day4">art <span class="calendar-day"> 4</span></a>
+<span aria-hidden="true" class="calendar-day5">art</span>
+</pre></main></body></html>`
+
+	stars, err := ParseStars(strings.NewReader(page))
+	if err != nil {
+		t.Fatalf("ParseStars() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		day  int
+		want int
+	}{
+		{name: "two stars", day: 1, want: 2},
+		{name: "one star", day: 2, want: 1},
+		{name: "stars from label", day: 3, want: 2},
+		{name: "no stars", day: 4, want: 0},
+		{name: "not released", day: 5, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stars[tt.day]; got != tt.want {
+				t.Errorf("ParseStars()[%d] = %d, want %d", tt.day, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package puzzle
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// ErrNoDescription is returned when a page has no puzzle description.
+var ErrNoDescription = errors.New("no puzzle description found")
+
+// titleRegex matches the heading of the first part, e.g. "--- Day 1: Historian Hysteria ---".
+var titleRegex = regexp.MustCompile(`(?s)^-*\s*(.*?)\s*-*$`)
+
+// Description holds the text of a puzzle.
+type Description struct {
+	// Title is the title of the puzzle, e.g. "Day 1: Historian Hysteria".
+	Title string
+	// Parts holds the Markdown of each part that is visible, so one part
+	// before part one is solved and two parts afterwards.
+	Parts []string
+}
+
+// ParseDescription extracts the <article class="day-desc"> blocks from a puzzle page.
+// Relative links are resolved against pageURL.
+func ParseDescription(page io.Reader, pageURL string) (*Description, error) {
+	doc, err := html.Parse(page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse page: %w", err)
+	}
+
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse page URL: %w", err)
+	}
+
+	articles := findArticles(doc)
+	if len(articles) == 0 {
+		return nil, ErrNoDescription
+	}
+
+	d := &Description{}
+	for i, article := range articles {
+		if i == 0 {
+			if h2 := findFirst(article, "h2"); h2 != nil {
+				d.Title = parseTitle(textContent(h2))
+			}
+		}
+		d.Parts = append(d.Parts, toMarkdown(article, base))
+	}
+
+	return d, nil
+}
+
+// parseTitle strips the dashes around the heading of the first part. Line breaks
+// inside the heading, e.g. from a <br>, are joined with a space.
+func parseTitle(heading string) string {
+	heading = strings.Join(strings.Fields(heading), " ")
+	if m := titleRegex.FindStringSubmatch(heading); m != nil {
+		return m[1]
+	}
+	return heading
+}
+
+// Markdown renders the whole description as a Markdown document.
+func (d *Description) Markdown(pageURL string) string {
+	var sb strings.Builder
+	title := d.Title
+	if title == "" {
+		title = "Puzzle"
+	}
+	fmt.Fprintf(&sb, "# %s\n\n<%s>\n", title, pageURL)
+
+	for i, part := range d.Parts {
+		fmt.Fprintf(&sb, "\n## Part %s\n\n%s\n", partName(i+1), part)
+	}
+	return sb.String()
+}
+
+func partName(part int) string {
+	switch part {
+	case 1:
+		return "One"
+	case 2:
+		return "Two"
+	default:
+		return fmt.Sprintf("%d", part)
+	}
+}
+
+// findArticles returns all <article class="day-desc"> nodes in document order.
+func findArticles(n *html.Node) []*html.Node {
+	var articles []*html.Node
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "article" && hasClass(n, "day-desc") {
+			articles = append(articles, n)
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return articles
+}
+
+// findFirst returns the first element with the given tag below n.
+func findFirst(n *html.Node, tag string) *html.Node {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.Data == tag {
+			return c
+		}
+		if found := findFirst(c, tag); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func hasClass(n *html.Node, class string) bool {
+	for _, attr := range n.Attr {
+		if attr.Key == "class" {
+			for _, c := range strings.Fields(attr.Val) {
+				if c == class {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// textContent returns the concatenated text of n and all its children, with <br> as a line break.
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	if n.Type == html.ElementNode && n.Data == "br" {
+		return "\n"
+	}
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sb.WriteString(textContent(c))
+	}
+	return sb.String()
+}
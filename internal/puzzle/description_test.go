@@ -0,0 +1,100 @@
+package puzzle
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+const pageURL = "https://adventofcode.com/2023/day/1"
+
+func parseTestPage(t *testing.T) *Description {
+	t.Helper()
+	f, err := os.Open("testdata/day.html")
+	if err != nil {
+		t.Fatalf("could not open test page: %v", err)
+	}
+	defer f.Close()
+
+	d, err := ParseDescription(f, pageURL)
+	if err != nil {
+		t.Fatalf("ParseDescription() error = %v", err)
+	}
+	return d
+}
+
+func TestParseDescription(t *testing.T) {
+	d := parseTestPage(t)
+
+	if d.Title != "Day 1: Trebuchet?!" {
+		t.Errorf("Title = %q, want %q", d.Title, "Day 1: Trebuchet?!")
+	}
+	if len(d.Parts) != 2 {
+		t.Fatalf("len(Parts) = %d, want 2", len(d.Parts))
+	}
+}
+
+func TestParseDescription_Title(t *testing.T) {
+	tests := []struct {
+		name string
+		h2   string
+		want string
+	}{
+		{name: "single line", h2: "--- Day 5: Print Queue ---", want: "Day 5: Print Queue"},
+		{name: "line break", h2: "--- Day 5:<br>Print Queue ---", want: "Day 5: Print Queue"},
+		{name: "wrapped markup", h2: "--- Day 5:\n  <em>Print\nQueue</em> ---", want: "Day 5: Print Queue"},
+		{name: "no dashes", h2: "Day 5", want: "Day 5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := `<html><body><article class="day-desc"><h2>` + tt.h2 + `</h2><p>text</p></article></body></html>`
+			d, err := ParseDescription(strings.NewReader(page), pageURL)
+			if err != nil {
+				t.Fatalf("ParseDescription() error = %v", err)
+			}
+			if d.Title != tt.want {
+				t.Errorf("Title = %q, want %q", d.Title, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDescription_NoArticle(t *testing.T) {
+	_, err := ParseDescription(strings.NewReader("<html><body><p>Please log in</p></body></html>"), pageURL)
+	if !errors.Is(err, ErrNoDescription) {
+		t.Errorf("ParseDescription() error = %v, want %v", err, ErrNoDescription)
+	}
+}
+
+func TestDescription_Markdown(t *testing.T) {
+	md := parseTestPage(t).Markdown(pageURL)
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"Title", "# Day 1: Trebuchet?!\n\n<" + pageURL + ">\n"},
+		{"Part headings", "## Part One\n"},
+		{"Part two heading", "## Part Two\n"},
+		{"Emphasis", "a specific *calibration value* that"},
+		{"Absolute link", "[map](https://adventofcode.com/2023/map)"},
+		{"Inline code", "`12`, `38`, `15`, and `77`"},
+		{"Highlighted answer", "produces *`142`*."},
+		{"Code block", "```\n1abc2\npqr3stu8vwx\na1b2c3d4e5f\ntreb7uchet\n```"},
+		{"List", "- First item\n- Second item with `x_y`\n  - Nested"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(md, tt.expected) {
+				t.Errorf("Markdown() does not contain %q:\n%s", tt.expected, md)
+			}
+		})
+	}
+
+	if strings.Contains(md, "--- Day 1") || strings.Contains(md, "Your puzzle answer was") {
+		t.Errorf("Markdown() contains text outside of the articles:\n%s", md)
+	}
+}
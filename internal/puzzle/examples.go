@@ -0,0 +1,183 @@
+package puzzle
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var integerRegex = regexp.MustCompile(`^-?\d+$`)
+
+// Example is an example input of a puzzle part.
+type Example struct {
+	Part  int
+	Input string
+	// Answer is the expected answer, or empty if it could not be detected.
+	Answer string
+}
+
+// Heuristics tune how examples and their answers are detected.
+// The zero value uses the first code block of each part with the last
+// highlighted value of that part as its answer.
+type Heuristics struct {
+	// MinLines is the minimum number of lines of a code block to be used as an example.
+	MinLines int
+	// AllBlocks uses every code block as an example instead of only the first of each part.
+	// Each block is then paired with the last highlighted value before the next block.
+	AllBlocks bool
+	// MaxAnswerLength ignores highlighted values longer than this. Zero means no limit.
+	MaxAnswerLength int
+	// NumericAnswers ignores highlighted values that are not integers.
+	NumericAnswers bool
+}
+
+// ParseExamples extracts the example inputs from the <pre><code> blocks of a puzzle page
+// and tries to detect their answers from the highlighted <code><em> values.
+// A part without code blocks of its own reuses the first example of the previous part.
+func ParseExamples(page io.Reader, h Heuristics) ([]Example, error) {
+	doc, err := html.Parse(page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse page: %w", err)
+	}
+
+	articles := findArticles(doc)
+	if len(articles) == 0 {
+		return nil, ErrNoDescription
+	}
+
+	var examples []Example
+	for i, article := range articles {
+		part := i + 1
+		found := h.examples(part, article)
+
+		if len(found) == 0 && len(examples) > 0 {
+			// Part two usually reuses the example of part one
+			reused := Example{Part: part, Input: examples[0].Input}
+			if answers := h.answers(article); len(answers) > 0 {
+				reused.Answer = answers[len(answers)-1]
+			}
+			found = append(found, reused)
+		}
+		examples = append(examples, found...)
+	}
+
+	return examples, nil
+}
+
+// examples returns the examples of a single article.
+func (h Heuristics) examples(part int, article *html.Node) []Example {
+	var examples []Example
+	var lastAnswer string
+	// finish assigns the answer seen since the previous block to the previous example
+	finish := func() {
+		if len(examples) > 0 && h.AllBlocks {
+			examples[len(examples)-1].Answer = lastAnswer
+		}
+		lastAnswer = ""
+	}
+
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type != html.ElementNode {
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				walk(c)
+			}
+			return
+		}
+
+		switch {
+		case n.Data == "pre":
+			input := textContent(n)
+			if !h.isExample(input) {
+				return
+			}
+			if !h.AllBlocks && len(examples) > 0 {
+				return
+			}
+			finish()
+			if !strings.HasSuffix(input, "\n") {
+				input += "\n"
+			}
+			examples = append(examples, Example{Part: part, Input: input})
+			return
+		case isHighlighted(n):
+			if answer := strings.TrimSpace(textContent(n)); h.isAnswer(answer) {
+				lastAnswer = answer
+			}
+			return
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(article)
+
+	if h.AllBlocks {
+		finish()
+	} else if len(examples) > 0 {
+		if answers := h.answers(article); len(answers) > 0 {
+			examples[0].Answer = answers[len(answers)-1]
+		}
+	}
+	return examples
+}
+
+// answers returns all highlighted values of an article that may be answers.
+func (h Heuristics) answers(article *html.Node) []string {
+	var answers []string
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "pre" {
+			return
+		}
+		if isHighlighted(n) {
+			if answer := strings.TrimSpace(textContent(n)); h.isAnswer(answer) {
+				answers = append(answers, answer)
+			}
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(article)
+	return answers
+}
+
+func (h Heuristics) isExample(input string) bool {
+	input = strings.TrimRight(input, "\n")
+	if strings.TrimSpace(input) == "" {
+		return false
+	}
+	return strings.Count(input, "\n")+1 >= h.MinLines
+}
+
+func (h Heuristics) isAnswer(answer string) bool {
+	if answer == "" {
+		return false
+	}
+	if h.MaxAnswerLength > 0 && len(answer) > h.MaxAnswerLength {
+		return false
+	}
+	if h.NumericAnswers && !integerRegex.MatchString(answer) {
+		return false
+	}
+	return true
+}
+
+// isHighlighted reports whether n is a <code><em>…</em></code> or <em><code>…</code></em>
+// value, which is how puzzles highlight the answers of their examples.
+func isHighlighted(n *html.Node) bool {
+	if n.Type != html.ElementNode || (n.Data != "code" && n.Data != "em") {
+		return false
+	}
+	inner := n.FirstChild
+	if inner == nil || inner.NextSibling != nil || inner.Type != html.ElementNode {
+		return false
+	}
+	return (n.Data == "code" && inner.Data == "em") || (n.Data == "em" && inner.Data == "code")
+}
@@ -0,0 +1,106 @@
+package puzzle
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseExamples(t *testing.T) {
+	f, err := os.Open("testdata/day.html")
+	if err != nil {
+		t.Fatalf("could not open test page: %v", err)
+	}
+	defer f.Close()
+
+	examples, err := ParseExamples(f, Heuristics{})
+	if err != nil {
+		t.Fatalf("ParseExamples() error = %v", err)
+	}
+
+	expected := []Example{
+		{Part: 1, Input: "1abc2\npqr3stu8vwx\na1b2c3d4e5f\ntreb7uchet\n", Answer: "142"},
+		{Part: 2, Input: "two1nine\neightwothree\nabcone2threexyz\n", Answer: "125"},
+	}
+	if len(examples) != len(expected) {
+		t.Fatalf("ParseExamples() returned %d examples, want %d", len(examples), len(expected))
+	}
+	for i := range expected {
+		if examples[i] != expected[i] {
+			t.Errorf("example %d = %+v, want %+v", i, examples[i], expected[i])
+		}
+	}
+}
+
+func TestParseExamples_Heuristics(t *testing.T) {
+	page := `<article class="day-desc"><h2>--- Day 2: Test ---</h2>
+<p>For example:</p>
+<pre><code>1 2 3
+</code></pre>
+<p>After one step:</p>
+<pre><code>2 3 4
+5 6 7
+</code></pre>
+<p>This gives <code><em>6</em></code>, so the answer is <code><em>abc</em></code>.</p>
+</article>
+<article class="day-desc"><h2>--- Part Two ---</h2>
+<p>Now the answer is <code><em>42</em></code> instead.</p>
+</article>`
+
+	tests := []struct {
+		name       string
+		heuristics Heuristics
+		expected   []Example
+	}{
+		{
+			"Defaults",
+			Heuristics{},
+			[]Example{
+				{Part: 1, Input: "1 2 3\n", Answer: "abc"},
+				{Part: 2, Input: "1 2 3\n", Answer: "42"},
+			},
+		},
+		{
+			"Numeric answers",
+			Heuristics{NumericAnswers: true},
+			[]Example{
+				{Part: 1, Input: "1 2 3\n", Answer: "6"},
+				{Part: 2, Input: "1 2 3\n", Answer: "42"},
+			},
+		},
+		{
+			"Minimum lines",
+			Heuristics{MinLines: 2, MaxAnswerLength: 1},
+			[]Example{
+				{Part: 1, Input: "2 3 4\n5 6 7\n", Answer: "6"},
+				{Part: 2, Input: "2 3 4\n5 6 7\n", Answer: ""},
+			},
+		},
+		{
+			"All blocks",
+			Heuristics{AllBlocks: true, NumericAnswers: true},
+			[]Example{
+				{Part: 1, Input: "1 2 3\n", Answer: ""},
+				{Part: 1, Input: "2 3 4\n5 6 7\n", Answer: "6"},
+				{Part: 2, Input: "1 2 3\n", Answer: "42"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			examples, err := ParseExamples(strings.NewReader(page), tt.heuristics)
+			if err != nil {
+				t.Fatalf("ParseExamples() error = %v", err)
+			}
+			if len(examples) != len(tt.expected) {
+				t.Fatalf("ParseExamples() = %+v, want %+v", examples, tt.expected)
+			}
+			for i := range tt.expected {
+				if examples[i] != tt.expected[i] {
+					t.Errorf("example %d = %+v, want %+v", i, examples[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package puzzle
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	spaceRegex    = regexp.MustCompile(`\s+`)
+	markdownChars = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `_`, `\_`)
+)
+
+// toMarkdown converts the content of an article to Markdown.
+// The article's heading is skipped, as the caller renders its own.
+func toMarkdown(article *html.Node, base *url.URL) string {
+	var blocks []string
+	for c := article.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.Data == "h2" {
+			continue
+		}
+		if b := block(c, base); b != "" {
+			blocks = append(blocks, b)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// block converts a block-level node to Markdown.
+func block(n *html.Node, base *url.URL) string {
+	if n.Type != html.ElementNode {
+		return strings.TrimSpace(inline(n, base))
+	}
+
+	switch n.Data {
+	case "pre":
+		return "```\n" + strings.TrimRight(textContent(n), "\n") + "\n```"
+	case "ul", "ol":
+		return list(n, base, 0)
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		return "### " + strings.TrimSpace(children(n, base))
+	default:
+		return strings.TrimSpace(children(n, base))
+	}
+}
+
+// list converts a <ul> or <ol> to Markdown, indenting nested lists by depth.
+func list(n *html.Node, base *url.URL, depth int) string {
+	var lines []string
+	indent := strings.Repeat("  ", depth)
+	number := 1
+	for li := n.FirstChild; li != nil; li = li.NextSibling {
+		if li.Type != html.ElementNode || li.Data != "li" {
+			continue
+		}
+
+		marker := "-"
+		if n.Data == "ol" {
+			marker = fmt.Sprintf("%d.", number)
+			number++
+		}
+
+		var text strings.Builder
+		var nested []string
+		for c := li.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.ElementNode && (c.Data == "ul" || c.Data == "ol") {
+				nested = append(nested, list(c, base, depth+1))
+				continue
+			}
+			text.WriteString(inline(c, base))
+		}
+
+		lines = append(lines, indent+marker+" "+strings.TrimSpace(text.String()))
+		lines = append(lines, nested...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// inline converts a node and its children to inline Markdown.
+func inline(n *html.Node, base *url.URL) string {
+	switch n.Type {
+	case html.TextNode:
+		return markdownChars.Replace(spaceRegex.ReplaceAllString(n.Data, " "))
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	switch n.Data {
+	case "em", "strong", "b", "i":
+		return wrap(children(n, base), "*")
+	case "code":
+		code := "`" + codeSpan(textContent(n)) + "`"
+		// <code><em>42</em></code> is how puzzles highlight answers
+		if em := n.FirstChild; em != nil && em.NextSibling == nil && em.Type == html.ElementNode && em.Data == "em" {
+			return "*" + code + "*"
+		}
+		return code
+	case "a":
+		href := attr(n, "href")
+		text := children(n, base)
+		if href == "" {
+			return text
+		}
+		if ref, err := url.Parse(href); err == nil && base != nil {
+			href = base.ResolveReference(ref).String()
+		}
+		return "[" + text + "](" + href + ")"
+	case "br":
+		return "  \n"
+	case "pre", "ul", "ol", "p":
+		return "\n\n" + block(n, base) + "\n\n"
+	default:
+		return children(n, base)
+	}
+}
+
+func children(n *html.Node, base *url.URL) string {
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sb.WriteString(inline(c, base))
+	}
+	return sb.String()
+}
+
+// wrap surrounds text with marker, keeping surrounding whitespace outside the markers
+// as Markdown does not allow emphasis to start or end with a space.
+func wrap(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	leading := text[:strings.Index(text, trimmed)]
+	trailing := text[len(leading)+len(trimmed):]
+	return leading + marker + trimmed + marker + trailing
+}
+
+// codeSpan prepares text for use in a code span, padding it if it contains backticks.
+func codeSpan(text string) string {
+	if strings.Contains(text, "`") {
+		return "` " + text + " `"
+	}
+	return text
+}
@@ -0,0 +1,63 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Domain-specific errors
+var (
+	ErrInvalidDay      = errors.New("invalid day")
+	ErrInvalidYear     = errors.New("invalid year")
+	ErrInvalidPart     = errors.New("invalid part")
+	ErrWorkdirRequired = errors.New("workdir is required")
+)
+
+// SolutionNotFoundError represents a day that has not been created yet
+type SolutionNotFoundError struct {
+	Year int
+	Day  int
+}
+
+func (e *SolutionNotFoundError) Error() string {
+	return fmt.Sprintf("day %d of %d does not exist - create it first", e.Day, e.Year)
+}
+
+// BuildError represents a solution that could not be compiled or started
+type BuildError struct {
+	Output string
+}
+
+func (e *BuildError) Error() string {
+	output := strings.TrimSpace(e.Output)
+	if output == "" {
+		return "failed to build solution"
+	}
+	return fmt.Sprintf("failed to build solution:\n%s", output)
+}
+
+// SolveError represents a part that returned an error
+type SolveError struct {
+	Part   int
+	Reason string
+}
+
+func (e *SolveError) Error() string {
+	return fmt.Sprintf("part %d failed: %s", e.Part, e.Reason)
+}
+
+// NewSolutionNotFoundError creates a new SolutionNotFoundError
+func NewSolutionNotFoundError(year, day int) *SolutionNotFoundError {
+	return &SolutionNotFoundError{Year: year, Day: day}
+}
+
+// NewBuildError creates a new BuildError
+func NewBuildError(output string) *BuildError {
+	return &BuildError{Output: output}
+}
+
+// NewSolveError creates a new SolveError
+func NewSolveError(part int, reason string) *SolveError {
+	return &SolveError{Part: part, Reason: reason}
+}
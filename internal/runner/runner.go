@@ -0,0 +1,320 @@
+package runner
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/gomod"
+	"github.com/frederik-suerig/advent-of-code/solutions"
+)
+
+//go:embed templates/harness.go.tmpl
+var harnessTemplate string
+
+// lookup finds registered solutions. Tests replace it to register fake days.
+var lookup = solutions.Lookup
+
+// Config holds the configuration for running the solution of an Advent of Code challenge
+type Config struct {
+	Year int
+	Day  int
+	// Part is the part to run, or 0 to run both parts.
+	Part    int
+	WorkDir string
+	// Rebuild compiles the day's package from source instead of using the
+	// solution registered in this binary, which may be outdated.
+	Rebuild bool
+	// Stdout receives what the solution prints to stdout, e.g. debug output. It defaults to
+	// os.Stdout; commands printing JSON pass os.Stderr, so prints don't end up between the events.
+	Stdout io.Writer
+}
+
+// Result holds the answer of a single part
+type Result struct {
+	Part     int
+	Answer   string
+	Duration time.Duration
+}
+
+type Runner struct {
+	day  int
+	year int
+	part int
+
+	rebuild bool
+	stdout  io.Writer
+
+	workDir string
+	dayDir  string
+}
+
+func NewRunner(cfg Config) (*Runner, error) {
+	r := &Runner{
+		day:     cfg.Day,
+		year:    cfg.Year,
+		part:    cfg.Part,
+		workDir: cfg.WorkDir,
+		rebuild: cfg.Rebuild,
+		stdout:  cfg.Stdout,
+	}
+	if r.stdout == nil {
+		r.stdout = os.Stdout
+	}
+	if err := r.init(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Runner) init() error {
+	if r.day <= 0 || r.day > 25 {
+		return fmt.Errorf("%w: %d", ErrInvalidDay, r.day)
+	}
+	if r.year <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidYear, r.year)
+	}
+	if r.day > aoc.DaysInYear(r.year) {
+		return fmt.Errorf("%w: %d for year %d", ErrInvalidDay, r.day, r.year)
+	}
+	if r.part < 0 || r.part > 2 {
+		return fmt.Errorf("%w: %d", ErrInvalidPart, r.part)
+	}
+
+	if r.workDir == "" {
+		return ErrWorkdirRequired
+	}
+
+	r.dayDir = filepath.Join(
+		r.workDir,
+		fmt.Sprintf("y%04d", r.year),
+		fmt.Sprintf("d%02d", r.day),
+	)
+
+	return nil
+}
+
+// Parts returns the parts that will be run.
+func (r *Runner) Parts() []int {
+	if r.part == 0 {
+		return []int{1, 2}
+	}
+	return []int{r.part}
+}
+
+// InputPath returns the path of the input the solution is run against.
+func (r *Runner) InputPath() string {
+	return filepath.Join(r.dayDir, "testdata", "input.txt")
+}
+
+// Run runs the selected parts against testdata/input.txt.
+// Solutions registered in this binary (see package solutions) are called in-process; all other
+// days, or all days with Rebuild, are compiled and run in a separate process.
+// Anything the solution prints to stdout goes to Config.Stdout, anything it prints to stderr is passed through.
+func (r *Runner) Run() ([]Result, error) {
+	if info, err := os.Stat(r.dayDir); err != nil || !info.IsDir() {
+		return nil, NewSolutionNotFoundError(r.year, r.day)
+	}
+
+	if !r.rebuild {
+		if solvers, ok := r.registered(); ok {
+			return r.runInProcess(solvers)
+		}
+	}
+	return r.runHarness()
+}
+
+// registered returns the solutions of the selected parts, and true if all of them are in the registry.
+func (r *Runner) registered() (map[int]solutions.Func, bool) {
+	solvers := make(map[int]solutions.Func)
+	for _, part := range r.Parts() {
+		solve, ok := lookup(r.year, r.day, part)
+		if !ok {
+			return nil, false
+		}
+		solvers[part] = solve
+	}
+	return solvers, true
+}
+
+func (r *Runner) runInProcess(solvers map[int]solutions.Func) ([]Result, error) {
+	restore, err := redirectStdout(r.stdout)
+	if err != nil {
+		return nil, err
+	}
+	defer restore()
+
+	results := make([]Result, 0, len(solvers))
+	for _, part := range r.Parts() {
+		res, err := r.solve(part, solvers[part])
+		if err != nil {
+			return results, err
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+func (r *Runner) solve(part int, solve solutions.Func) (Result, error) {
+	file, err := os.Open(r.InputPath())
+	if err != nil {
+		return Result{}, NewSolveError(part, fmt.Sprintf("could not open input file: %v", err))
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var answer bytes.Buffer
+	start := time.Now()
+	err = solve(file, &answer)
+	elapsed := time.Since(start)
+	if err != nil {
+		return Result{}, NewSolveError(part, err.Error())
+	}
+
+	return Result{Part: part, Answer: strings.TrimSpace(answer.String()), Duration: elapsed}, nil
+}
+
+// runHarness builds a small program calling the day's PartOne and PartTwo and runs it.
+func (r *Runner) runHarness() ([]Result, error) {
+	module, err := gomod.ModulePath(r.workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// The harness must live inside the module to import the solution.
+	// Directories starting with "." are ignored by ./... patterns.
+	tmpDir, err := os.MkdirTemp(r.workDir, ".aoc-run-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+
+	resultPath := filepath.Join(tmpDir, "results.json")
+	if err := r.writeHarness(tmpDir, module, resultPath); err != nil {
+		return nil, err
+	}
+
+	binary := filepath.Join(tmpDir, "harness")
+	build := exec.Command("go", "build", "-o", binary, ".")
+	build.Dir = tmpDir
+	if output, err := build.CombinedOutput(); err != nil {
+		return nil, NewBuildError(string(output))
+	}
+
+	run := exec.Command(binary)
+	run.Dir = r.dayDir
+	run.Stdout = r.stdout
+	run.Stderr = os.Stderr
+	if err := run.Run(); err != nil {
+		return nil, fmt.Errorf("failed to run solution: %w", err)
+	}
+
+	return readResults(resultPath)
+}
+
+// redirectStdout points os.Stdout at w, so prints of solutions called in-process go there,
+// and returns a function restoring it.
+func redirectStdout(w io.Writer) (func(), error) {
+	orig := os.Stdout
+	if f, ok := w.(*os.File); ok {
+		os.Stdout = f
+		return func() { os.Stdout = orig }, nil
+	}
+
+	pr, pw, err := os.Pipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to redirect output: %w", err)
+	}
+	done := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(w, pr)
+		close(done)
+	}()
+
+	os.Stdout = pw
+	return func() {
+		os.Stdout = orig
+		_ = pw.Close()
+		<-done
+		_ = pr.Close()
+	}, nil
+}
+
+type harnessData struct {
+	ImportPath string
+	InputPath  string
+	ResultPath string
+	Parts      []int
+}
+
+func (r *Runner) writeHarness(dir, module, resultPath string) error {
+	tmpl, err := template.New("harness").Parse(harnessTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	rel, err := filepath.Rel(r.workDir, r.dayDir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve package path: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, harnessData{
+		ImportPath: module + "/" + filepath.ToSlash(rel),
+		InputPath:  r.InputPath(),
+		ResultPath: resultPath,
+		Parts:      r.Parts(),
+	}); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write harness: %w", err)
+	}
+	return nil
+}
+
+type harnessResult struct {
+	Part     int           `json:"part"`
+	Answer   string        `json:"answer"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+}
+
+func readResults(path string) ([]Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read results: %w", err)
+	}
+
+	var raw []harnessResult
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse results: %w", err)
+	}
+
+	results := make([]Result, 0, len(raw))
+	for _, res := range raw {
+		if res.Error != "" {
+			return results, NewSolveError(res.Part, res.Error)
+		}
+		results = append(results, Result{
+			Part:     res.Part,
+			Answer:   strings.TrimSpace(res.Answer),
+			Duration: res.Duration,
+		})
+	}
+	return results, nil
+}
@@ -0,0 +1,268 @@
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/solutions"
+)
+
+const testSolution = `package d05
+
+import (
+	"fmt"
+	"io"
+)
+
+func PartOne(r io.Reader, w io.Writer) error {
+	fmt.Println("debug one")
+	_, err := fmt.Fprintln(w, "harness 1")
+	return err
+}
+
+func PartTwo(r io.Reader, w io.Writer) error {
+	_, err := fmt.Fprintln(w, "harness 2")
+	return err
+}
+`
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newWorkDir returns a module with day 5 of 2024, whose solution.go has the given source.
+func newWorkDir(t *testing.T, solution string) string {
+	t.Helper()
+
+	workDir := t.TempDir()
+	writeFile(t, filepath.Join(workDir, "go.mod"), "module example.com/aoc\n\ngo 1.21\n")
+	writeFile(t, filepath.Join(workDir, "y2024", "d05", "solution.go"), solution)
+	writeFile(t, filepath.Join(workDir, "y2024", "d05", "testdata", "input.txt"), "3\n")
+	return workDir
+}
+
+// register replaces the registry with solve for both parts of day 5 of 2024 until the test ends.
+func register(t *testing.T, solve solutions.Func) {
+	t.Helper()
+
+	orig := lookup
+	t.Cleanup(func() { lookup = orig })
+	lookup = func(year, day, part int) (solutions.Func, bool) {
+		if year != 2024 || day != 5 || solve == nil {
+			return nil, false
+		}
+		return solve, true
+	}
+}
+
+func requireGo(t *testing.T) {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("compiles a harness")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go is not installed")
+	}
+}
+
+func TestRunner_Run(t *testing.T) {
+	inProcess := func(r io.Reader, w io.Writer) error {
+		input, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		fmt.Println("debug in-process")
+		_, err = fmt.Fprintf(w, "in-process %s\n", strings.TrimSpace(string(input)))
+		return err
+	}
+
+	tests := []struct {
+		name       string
+		registered solutions.Func
+		rebuild    bool
+		part       int
+		solution   string
+		want       []string
+		wantStdout string
+		wantErr    any
+	}{
+		{
+			name:       "Registered day runs in-process",
+			registered: inProcess,
+			solution:   testSolution,
+			want:       []string{"in-process 3", "in-process 3"},
+			wantStdout: "debug in-process\ndebug in-process\n",
+		},
+		{
+			name:       "Registered part",
+			registered: inProcess,
+			part:       2,
+			solution:   testSolution,
+			want:       []string{"in-process 3"},
+			wantStdout: "debug in-process\n",
+		},
+		{
+			name:       "Registered day fails",
+			registered: func(r io.Reader, w io.Writer) error { return errors.New("no answer") },
+			solution:   testSolution,
+			wantErr:    new(*SolveError),
+		},
+		{
+			name:       "Missing day falls back to the harness",
+			solution:   testSolution,
+			want:       []string{"harness 1", "harness 2"},
+			wantStdout: "debug one\n",
+		},
+		{
+			name:       "Rebuild skips the registry",
+			registered: inProcess,
+			rebuild:    true,
+			part:       1,
+			solution:   testSolution,
+			want:       []string{"harness 1"},
+			wantStdout: "debug one\n",
+		},
+		{
+			name:     "Compile error in the harness",
+			solution: strings.Replace(testSolution, "return err\n}\n\nfunc PartTwo", "return nill\n}\n\nfunc PartTwo", 1),
+			wantErr:  new(*BuildError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.registered == nil || tt.rebuild {
+				requireGo(t)
+			}
+			register(t, tt.registered)
+
+			var stdout bytes.Buffer
+			r, err := NewRunner(Config{
+				Year:    2024,
+				Day:     5,
+				Part:    tt.part,
+				WorkDir: newWorkDir(t, tt.solution),
+				Rebuild: tt.rebuild,
+				Stdout:  &stdout,
+			})
+			if err != nil {
+				t.Fatalf("NewRunner() error = %v", err)
+			}
+
+			results, err := r.Run()
+			if tt.wantErr != nil {
+				if !errors.As(err, tt.wantErr) {
+					t.Fatalf("Run() error = %v, want %T", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			var got []string
+			for _, res := range results {
+				got = append(got, res.Answer)
+			}
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("Run() answers = %q, want %q", got, tt.want)
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("Run() printed %q, want %q", stdout.String(), tt.wantStdout)
+			}
+		})
+	}
+}
+
+func TestRunner_RunMissingDay(t *testing.T) {
+	r, err := NewRunner(Config{Year: 2024, Day: 6, WorkDir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewRunner() error = %v", err)
+	}
+
+	var notFound *SolutionNotFoundError
+	if _, err := r.Run(); !errors.As(err, &notFound) {
+		t.Errorf("Run() error = %v, want SolutionNotFoundError", err)
+	}
+}
+
+func TestRedirectStdout(t *testing.T) {
+	orig := os.Stdout
+
+	var buf bytes.Buffer
+	restore, err := redirectStdout(&buf)
+	if err != nil {
+		t.Fatalf("redirectStdout() error = %v", err)
+	}
+	fmt.Print("captured")
+	restore()
+
+	if os.Stdout != orig {
+		t.Errorf("redirectStdout() did not restore os.Stdout")
+	}
+	if buf.String() != "captured" {
+		t.Errorf("redirectStdout() captured %q, want %q", buf.String(), "captured")
+	}
+}
+
+func TestReadResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Result
+		wantErr bool
+	}{
+		{
+			name:    "Answers are trimmed",
+			content: `[{"part": 1, "answer": "42\n", "duration": 1000}, {"part": 2, "answer": " 7 ", "duration": 2000}]`,
+			want:    []Result{{Part: 1, Answer: "42", Duration: time.Microsecond}, {Part: 2, Answer: "7", Duration: 2 * time.Microsecond}},
+		},
+		{
+			name:    "Failed part stops at its error",
+			content: `[{"part": 1, "answer": "42", "duration": 1000}, {"part": 2, "error": "no answer"}]`,
+			want:    []Result{{Part: 1, Answer: "42", Duration: time.Microsecond}},
+			wantErr: true,
+		},
+		{
+			name:    "Invalid JSON",
+			content: `[{"part": 1`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "results.json")
+			writeFile(t, path, tt.content)
+
+			got, err := readResults(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readResults() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("readResults() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("readResults()[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package status
+
+import "errors"
+
+// Domain-specific errors
+var (
+	ErrInvalidYear      = errors.New("invalid year")
+	ErrWorkdirRequired  = errors.New("workdir is required")
+	ErrReporterRequired = errors.New("reporter is required")
+)
@@ -0,0 +1,206 @@
+package status
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/doc"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/bench"
+	"github.com/frederik-suerig/advent-of-code/internal/puzzle"
+	"github.com/frederik-suerig/advent-of-code/internal/submit"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+)
+
+// Config holds the configuration for the overview of an Advent of Code year
+type Config struct {
+	Year    int
+	WorkDir string
+	// Client fetches the stars from the event page. It is optional and only used with a session cookie.
+	Client *aoc.Client
+	// Reporter shows warnings.
+	Reporter ui.Reporter
+}
+
+// Part holds the progress of a single part
+type Part struct {
+	// Solved is true if the part's example in solution_test.go has a filled-in // Output: comment
+	// or answers.json has a correct answer for it.
+	Solved bool
+	// Star is true if the event page shows the part's star.
+	Star bool
+	// Duration is the median of the part's latest benchmark, or zero if it was never benchmarked.
+	Duration time.Duration
+}
+
+// Day holds the progress of a single day
+type Day struct {
+	Day int
+	// Exists is true if the day has been created.
+	Exists   bool
+	HasInput bool
+	Parts    [2]Part
+}
+
+// Stars returns the number of stars of the day.
+func (d Day) Stars() int {
+	stars := 0
+	for _, p := range d.Parts {
+		if p.Star {
+			stars++
+		}
+	}
+	return stars
+}
+
+type Scanner struct {
+	year    int
+	workDir string
+	client  *aoc.Client
+
+	reporter ui.Reporter
+}
+
+func NewScanner(cfg Config) (*Scanner, error) {
+	s := &Scanner{
+		year:     cfg.Year,
+		workDir:  cfg.WorkDir,
+		client:   cfg.Client,
+		reporter: cfg.Reporter,
+	}
+	if err := s.init(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Scanner) init() error {
+	if s.year <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidYear, s.year)
+	}
+
+	if s.workDir == "" {
+		return ErrWorkdirRequired
+	}
+
+	if s.reporter == nil {
+		return ErrReporterRequired
+	}
+
+	return nil
+}
+
+// Days returns how many puzzles the year has.
+func (s *Scanner) Days() int {
+	return aoc.DaysInYear(s.year)
+}
+
+// Scan collects the progress of every day of the year from the working directory, the
+// benchmark history and, if the client has a cookie, the event page. Failing to fetch
+// the stars only prints a warning.
+func (s *Scanner) Scan() ([]Day, error) {
+	history, err := bench.LoadHistory(filepath.Join(s.workDir, bench.DefaultHistoryFile))
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]Day, s.Days())
+	for i := range days {
+		days[i], err = s.scanDay(i+1, history)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s.client == nil || !s.client.HasCookie() {
+		return days, nil
+	}
+
+	stars, err := s.stars()
+	if err != nil {
+		s.reporter.Warning("Could not fetch stars: %s", err)
+		return days, nil
+	}
+	for i := range days {
+		for part := range days[i].Parts {
+			days[i].Parts[part].Star = stars[i+1] > part
+		}
+	}
+	return days, nil
+}
+
+func (s *Scanner) scanDay(day int, history *bench.History) (Day, error) {
+	d := Day{Day: day}
+	dir := filepath.Join(s.workDir, fmt.Sprintf("y%04d", s.year), fmt.Sprintf("d%02d", day))
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return d, nil
+	}
+	d.Exists = true
+
+	if info, err := os.Stat(filepath.Join(dir, "testdata", "input.txt")); err == nil && info.Size() > 0 {
+		d.HasInput = true
+	}
+
+	outputs, err := exampleOutputs(filepath.Join(dir, "solution_test.go"))
+	if err != nil {
+		return d, err
+	}
+	answers, err := submit.LoadHistory(filepath.Join(dir, submit.AnswersFile))
+	if err != nil {
+		return d, err
+	}
+
+	for i, name := range []string{"PartOne", "PartTwo"} {
+		_, submitted := answers.Correct(i + 1)
+		d.Parts[i].Solved = outputs[name] != "" || submitted
+		if entry, ok := history.Last(s.year, day, i+1); ok {
+			d.Parts[i].Duration = entry.Median
+		}
+	}
+	return d, nil
+}
+
+// exampleOutputs returns the // Output: comment of each example function in a test file,
+// keyed by the name of the function the example belongs to.
+func exampleOutputs(path string) (map[string]string, error) {
+	outputs := make(map[string]string)
+
+	src, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return outputs, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), path, src, parser.ParseComments)
+	if err != nil {
+		// A test that doesn't compile has no verified answers
+		return outputs, nil
+	}
+
+	for _, ex := range doc.Examples(file) {
+		outputs[ex.Name] = strings.TrimSpace(ex.Output)
+	}
+	return outputs, nil
+}
+
+func (s *Scanner) stars() (map[int]int, error) {
+	page, err := s.client.Event(s.year)
+	if err != nil {
+		var requestErr *aoc.RequestError
+		if errors.As(err, &requestErr) {
+			return nil, errors.New(requestErr.Reason)
+		}
+		return nil, err
+	}
+	return puzzle.ParseStars(bytes.NewReader(page))
+}
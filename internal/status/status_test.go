@@ -0,0 +1,161 @@
+package status
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/bench"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+)
+
+const solvedTest = `package d01
+
+func ExamplePartOne() {
+	// Output: 42
+}
+
+func ExamplePartTwo() {
+	// Output:
+}
+`
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScanner_Scan(t *testing.T) {
+	workDir := t.TempDir()
+	writeFile(t, filepath.Join(workDir, "y2024", "d01", "solution_test.go"), solvedTest)
+	writeFile(t, filepath.Join(workDir, "y2024", "d01", "testdata", "input.txt"), "1\n")
+	writeFile(t, filepath.Join(workDir, "y2024", "d02", "solution.go"), "package d02\n")
+	writeFile(t, filepath.Join(workDir, "y2024", "d02", "answers.json"), `[{"part": 2, "answer": "7", "verdict": "correct"}]`)
+
+	history, err := bench.LoadHistory(filepath.Join(workDir, bench.DefaultHistoryFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := history.Append(time.Now(), []bench.Result{{Year: 2024, Day: 1, Part: 1, Median: time.Millisecond}}); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2024" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`<pre class="calendar">
+<a aria-label="Day 1, one star" href="/2024/day/1" class="calendar-day1 calendar-complete">1</a>
+<a aria-label="Day 2, two stars" href="/2024/day/2" class="calendar-day2 calendar-verycomplete">2</a>
+</pre>`))
+	}))
+	defer server.Close()
+
+	client, err := aoc.NewClient(aoc.Config{BaseURL: server.URL, Cookie: "secret", CacheDir: t.TempDir(), MinInterval: -1})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	s, err := NewScanner(Config{Year: 2024, WorkDir: workDir, Client: client, Reporter: ui.NewQuiet(io.Discard, io.Discard)})
+	if err != nil {
+		t.Fatalf("NewScanner() error = %v", err)
+	}
+
+	days, err := s.Scan()
+	if err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if len(days) != 25 {
+		t.Fatalf("Scan() returned %d days, want 25", len(days))
+	}
+
+	tests := []struct {
+		name string
+		got  Day
+		want Day
+	}{
+		{
+			name: "solved with input and timing",
+			got:  days[0],
+			want: Day{Day: 1, Exists: true, HasInput: true, Parts: [2]Part{{Solved: true, Star: true, Duration: time.Millisecond}, {}}},
+		},
+		{
+			name: "starred without input",
+			got:  days[1],
+			want: Day{Day: 2, Exists: true, Parts: [2]Part{{Star: true}, {Solved: true, Star: true}}},
+		},
+		{
+			name: "not created",
+			got:  days[2],
+			want: Day{Day: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Scan() day = %+v, want %+v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanner_ScanWarnsWithoutStars(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "down", http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client, err := aoc.NewClient(aoc.Config{BaseURL: server.URL, Cookie: "secret", CacheDir: t.TempDir(), MinInterval: -1})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var out bytes.Buffer
+	s, err := NewScanner(Config{Year: 2024, WorkDir: t.TempDir(), Client: client, Reporter: ui.NewJSON(&out)})
+	if err != nil {
+		t.Fatalf("NewScanner() error = %v", err)
+	}
+
+	if _, err := s.Scan(); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte(`"event":"warning"`)) || !bytes.Contains(out.Bytes(), []byte("Could not fetch stars")) {
+		t.Errorf("Scan() reported %q, want a warning about the stars", out.String())
+	}
+}
+
+func TestScanner_Days(t *testing.T) {
+	tests := []struct {
+		name string
+		year int
+		want int
+	}{
+		{name: "before 2025", year: 2024, want: 25},
+		{name: "from 2025", year: 2025, want: 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewScanner(Config{Year: tt.year, WorkDir: t.TempDir(), Reporter: ui.NewQuiet(io.Discard, io.Discard)})
+			if err != nil {
+				t.Fatalf("NewScanner() error = %v", err)
+			}
+			if got := s.Days(); got != tt.want {
+				t.Errorf("Days() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
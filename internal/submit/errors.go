@@ -0,0 +1,97 @@
+package submit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Domain-specific errors
+var (
+	ErrInvalidDay       = errors.New("invalid day")
+	ErrInvalidYear      = errors.New("invalid year")
+	ErrInvalidPart      = errors.New("invalid part")
+	ErrAnswerRequired   = errors.New("answer is required")
+	ErrCookieRequired   = errors.New("cookie is required")
+	ErrReporterRequired = errors.New("reporter is required")
+	ErrAlreadySolved    = errors.New("this part is already solved")
+	ErrUnknownResponse  = errors.New("unrecognized response from adventofcode.com")
+)
+
+// WrongAnswerError represents an answer that was rejected by adventofcode.com
+type WrongAnswerError struct {
+	Answer  string
+	Verdict Verdict
+	// Wait is the time until the next answer may be submitted, if the response mentioned it.
+	Wait time.Duration
+}
+
+func (e *WrongAnswerError) Error() string {
+	msg := fmt.Sprintf("%q is not the right answer", e.Answer)
+	switch e.Verdict {
+	case VerdictTooHigh:
+		msg += " - your answer is too high"
+	case VerdictTooLow:
+		msg += " - your answer is too low"
+	}
+	if e.Wait > 0 {
+		msg += fmt.Sprintf(" (wait %s before trying again)", e.Wait)
+	}
+	return msg
+}
+
+// WaitError represents a submission that was rejected because the last one was too recent
+type WaitError struct {
+	Wait time.Duration
+}
+
+func (e *WaitError) Error() string {
+	if e.Wait > 0 {
+		return fmt.Sprintf("you gave an answer too recently - wait %s before trying again", e.Wait)
+	}
+	return "you gave an answer too recently - wait before trying again"
+}
+
+// RejectedAnswerError represents an answer that was not submitted, because the
+// answer history shows it is wrong
+type RejectedAnswerError struct {
+	Answer string
+	Reason string
+}
+
+func (e *RejectedAnswerError) Error() string {
+	return fmt.Sprintf("%q was not submitted: %s", e.Answer, e.Reason)
+}
+
+// SubmitError represents an error sending the answer to adventofcode.com
+type SubmitError struct {
+	Reason string
+	Status int
+}
+
+func (e *SubmitError) Error() string {
+	if e.Status > 0 {
+		return fmt.Sprintf("failed to submit answer: %s (status %d)", e.Reason, e.Status)
+	}
+	return fmt.Sprintf("failed to submit answer: %s", e.Reason)
+}
+
+// NewWrongAnswerError creates a new WrongAnswerError
+func NewWrongAnswerError(answer string, verdict Verdict, wait time.Duration) *WrongAnswerError {
+	return &WrongAnswerError{Answer: answer, Verdict: verdict, Wait: wait}
+}
+
+// NewRejectedAnswerError creates a new RejectedAnswerError
+func NewRejectedAnswerError(answer, reason string) *RejectedAnswerError {
+	return &RejectedAnswerError{Answer: answer, Reason: reason}
+}
+
+// NewWaitError creates a new WaitError
+func NewWaitError(wait time.Duration) *WaitError {
+	return &WaitError{Wait: wait}
+}
+
+// NewSubmitError creates a new SubmitError
+func NewSubmitError(reason string, status int) *SubmitError {
+	return &SubmitError{Reason: reason, Status: status}
+}
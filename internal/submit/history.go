@@ -0,0 +1,133 @@
+package submit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+// AnswersFile is the name of a day's answer history, stored next to testdata/.
+const AnswersFile = "answers.json"
+
+// Attempt is a submitted answer and its verdict
+type Attempt struct {
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Time    time.Time `json:"time"`
+}
+
+// History holds all answers submitted for a day
+type History struct {
+	path     string
+	Attempts []Attempt
+}
+
+// LoadHistory reads the answer history at path. A missing file is an empty history.
+func LoadHistory(path string) (*History, error) {
+	h := &History{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read answer history: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &h.Attempts); err != nil {
+		return nil, fmt.Errorf("failed to parse answer history %s: %w", path, err)
+	}
+	return h, nil
+}
+
+// Record adds an attempt and writes the history.
+func (h *History) Record(a Attempt) error {
+	h.Attempts = append(h.Attempts, a)
+
+	data, err := json.MarshalIndent(h.Attempts, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode answer history: %w", err)
+	}
+	if err := os.WriteFile(h.path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write answer history: %w", err)
+	}
+	return nil
+}
+
+// Correct returns the accepted answer of a part and true if there is one.
+func (h *History) Correct(part int) (string, bool) {
+	for _, a := range h.Attempts {
+		if a.Part == part && a.Verdict == VerdictCorrect {
+			return a.Answer, true
+		}
+	}
+	return "", false
+}
+
+// Check refuses an answer the history already proves wrong: one that was rejected
+// before, or a number outside the bounds set by answers that were too low or too high.
+// Returns ErrAlreadySolved if the part already has a correct answer.
+func (h *History) Check(part int, answer string) error {
+	if _, ok := h.Correct(part); ok {
+		return ErrAlreadySolved
+	}
+
+	value, numeric := new(big.Int).SetString(answer, 10)
+	var low, high *big.Int
+	for _, a := range h.Attempts {
+		if a.Part != part {
+			continue
+		}
+		if a.Answer == answer {
+			return NewRejectedAnswerError(answer, fmt.Sprintf("it was already rejected as %s", a.Verdict))
+		}
+
+		bound, ok := new(big.Int).SetString(a.Answer, 10)
+		if !ok {
+			continue
+		}
+		switch a.Verdict {
+		case VerdictTooLow:
+			if low == nil || bound.Cmp(low) > 0 {
+				low = bound
+			}
+		case VerdictTooHigh:
+			if high == nil || bound.Cmp(high) < 0 {
+				high = bound
+			}
+		}
+	}
+
+	if !numeric {
+		return nil
+	}
+	if low != nil && value.Cmp(low) <= 0 {
+		return NewRejectedAnswerError(answer, fmt.Sprintf("it must be higher than %s, which was too low", low))
+	}
+	if high != nil && value.Cmp(high) >= 0 {
+		return NewRejectedAnswerError(answer, fmt.Sprintf("it must be lower than %s, which was too high", high))
+	}
+	return nil
+}
+
+// MarshalText stores a verdict by its name.
+func (v Verdict) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText reads a verdict stored by MarshalText.
+func (v *Verdict) UnmarshalText(text []byte) error {
+	name := strings.TrimSpace(string(text))
+	for candidate := VerdictUnknown; candidate <= VerdictAlreadySolved; candidate++ {
+		if candidate.String() == name {
+			*v = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown verdict %q", name)
+}
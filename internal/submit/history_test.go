@@ -0,0 +1,128 @@
+package submit
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+)
+
+func TestHistory_Check(t *testing.T) {
+	h := &History{Attempts: []Attempt{
+		{Part: 1, Answer: "100", Verdict: VerdictTooLow},
+		{Part: 1, Answer: "150", Verdict: VerdictTooLow},
+		{Part: 1, Answer: "500", Verdict: VerdictTooHigh},
+		{Part: 1, Answer: "300", Verdict: VerdictWrong},
+		{Part: 1, Answer: "abc", Verdict: VerdictWrong},
+		{Part: 2, Answer: "42", Verdict: VerdictCorrect},
+	}}
+
+	tests := []struct {
+		name         string
+		part         int
+		answer       string
+		wantRejected bool
+		wantErr      error
+	}{
+		{name: "inside bounds", part: 1, answer: "200"},
+		{name: "known too low", part: 1, answer: "100", wantRejected: true},
+		{name: "known wrong", part: 1, answer: "300", wantRejected: true},
+		{name: "below lower bound", part: 1, answer: "120", wantRejected: true},
+		{name: "on lower bound", part: 1, answer: "150", wantRejected: true},
+		{name: "above upper bound", part: 1, answer: "99999999999999999999", wantRejected: true},
+		{name: "known wrong text", part: 1, answer: "abc", wantRejected: true},
+		{name: "new text", part: 1, answer: "abd"},
+		{name: "solved part", part: 2, answer: "43", wantErr: ErrAlreadySolved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := h.Check(tt.part, tt.answer)
+			var rejected *RejectedAnswerError
+			if got := errors.As(err, &rejected); got != tt.wantRejected {
+				t.Errorf("Check() error = %v, want rejected %v", err, tt.wantRejected)
+			}
+			if !tt.wantRejected && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Check() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSubmitter_RunWithHistory(t *testing.T) {
+	requests := 0
+	reply := `<article><p>That's not the right answer; your answer is too high.</p></article>`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(reply))
+	}))
+	defer server.Close()
+
+	workDir := t.TempDir()
+	dayDir := filepath.Join(workDir, "y2024", "d05")
+	if err := os.MkdirAll(dayDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, time.December, 5, 6, 0, 0, 0, time.UTC)
+
+	submitAnswer := func(answer string) error {
+		s, err := NewSubmitter(Config{
+			Year:     2024,
+			Day:      5,
+			Part:     1,
+			Answer:   answer,
+			WorkDir:  workDir,
+			Client:   newClient(t, server.URL, "secret"),
+			Now:      func() time.Time { return now },
+			Reporter: ui.NewQuiet(io.Discard, io.Discard),
+		})
+		if err != nil {
+			t.Fatalf("NewSubmitter() error = %v", err)
+		}
+		_, err = s.Run()
+		return err
+	}
+
+	var wrongErr *WrongAnswerError
+	if err := submitAnswer("500"); !errors.As(err, &wrongErr) {
+		t.Fatalf("Run() error = %v, want WrongAnswerError", err)
+	}
+
+	var rejected *RejectedAnswerError
+	for _, answer := range []string{"500", "600"} {
+		if err := submitAnswer(answer); !errors.As(err, &rejected) {
+			t.Errorf("Run(%s) error = %v, want RejectedAnswerError", answer, err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("server received %d requests, want 1", requests)
+	}
+
+	reply = `<article><p>That's the right answer!</p></article>`
+	if err := submitAnswer("400"); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	h, err := LoadHistory(filepath.Join(dayDir, AnswersFile))
+	if err != nil {
+		t.Fatalf("LoadHistory() error = %v", err)
+	}
+	want := []Attempt{
+		{Part: 1, Answer: "500", Verdict: VerdictTooHigh, Time: now},
+		{Part: 1, Answer: "400", Verdict: VerdictCorrect, Time: now},
+	}
+	if len(h.Attempts) != len(want) {
+		t.Fatalf("LoadHistory() = %+v, want %+v", h.Attempts, want)
+	}
+	for i := range want {
+		if got := h.Attempts[i]; got.Part != want[i].Part || got.Answer != want[i].Answer || got.Verdict != want[i].Verdict || !got.Time.Equal(want[i].Time) {
+			t.Errorf("LoadHistory()[%d] = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package submit
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is the outcome of a submission as reported by adventofcode.com.
+type Verdict int
+
+const (
+	// VerdictUnknown means the response could not be interpreted.
+	VerdictUnknown Verdict = iota
+	// VerdictCorrect means the answer was accepted.
+	VerdictCorrect
+	// VerdictTooHigh means the answer was rejected for being too high.
+	VerdictTooHigh
+	// VerdictTooLow means the answer was rejected for being too low.
+	VerdictTooLow
+	// VerdictWrong means the answer was rejected without a hint.
+	VerdictWrong
+	// VerdictWait means an answer was submitted too recently.
+	VerdictWait
+	// VerdictAlreadySolved means the part has already been completed.
+	VerdictAlreadySolved
+)
+
+// String returns a string representation of the verdict.
+func (v Verdict) String() string {
+	switch v {
+	case VerdictCorrect:
+		return "correct"
+	case VerdictTooHigh:
+		return "too high"
+	case VerdictTooLow:
+		return "too low"
+	case VerdictWrong:
+		return "wrong"
+	case VerdictWait:
+		return "wait"
+	case VerdictAlreadySolved:
+		return "already solved"
+	default:
+		return "unknown"
+	}
+}
+
+// Response is the interpreted reply to a submission.
+type Response struct {
+	Verdict Verdict
+	// Message is the plain text of the reply's article.
+	Message string
+	// Wait is the time until the next answer may be submitted, if the reply mentioned it.
+	Wait time.Duration
+}
+
+// isFinal reports whether the verdict judged the answer, so it is worth remembering.
+func (r *Response) isFinal() bool {
+	switch r.Verdict {
+	case VerdictCorrect, VerdictTooHigh, VerdictTooLow, VerdictWrong:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	articleRegex = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRegex     = regexp.MustCompile(`<[^>]+>`)
+	spaceRegex   = regexp.MustCompile(`\s+`)
+
+	// "You have 1m 23s left to wait." / "You have 45s left to wait."
+	leftToWaitRegex = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	// "Please wait one minute before trying again." / "please wait 5 minutes before trying again."
+	pleaseWaitRegex = regexp.MustCompile(`(?i)wait (one|two|three|four|five|ten|\d+) minutes? before trying again`)
+)
+
+var numberWords = map[string]int{
+	"one":   1,
+	"two":   2,
+	"three": 3,
+	"four":  4,
+	"five":  5,
+	"ten":   10,
+}
+
+// Classify interprets the HTML body returned by the answer endpoint.
+func Classify(body string) *Response {
+	message := extractMessage(body)
+	resp := &Response{Message: message}
+
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		resp.Verdict = VerdictCorrect
+	case strings.Contains(message, "That's not the right answer"):
+		switch {
+		case strings.Contains(message, "too high"):
+			resp.Verdict = VerdictTooHigh
+		case strings.Contains(message, "too low"):
+			resp.Verdict = VerdictTooLow
+		default:
+			resp.Verdict = VerdictWrong
+		}
+		resp.Wait = parsePleaseWait(message)
+	case strings.Contains(message, "You gave an answer too recently"):
+		resp.Verdict = VerdictWait
+		resp.Wait = parseLeftToWait(message)
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		resp.Verdict = VerdictAlreadySolved
+	default:
+		resp.Verdict = VerdictUnknown
+	}
+
+	return resp
+}
+
+// extractMessage returns the plain text of the first <article> in body,
+// or of the whole body if there is none.
+func extractMessage(body string) string {
+	text := body
+	if match := articleRegex.FindStringSubmatch(body); len(match) > 1 {
+		text = match[1]
+	}
+	text = tagRegex.ReplaceAllString(text, "")
+	text = html.UnescapeString(text)
+	return strings.TrimSpace(spaceRegex.ReplaceAllString(text, " "))
+}
+
+func parseLeftToWait(message string) time.Duration {
+	match := leftToWaitRegex.FindStringSubmatch(message)
+	if len(match) < 3 {
+		return 0
+	}
+	minutes, _ := strconv.Atoi(match[1])
+	seconds, _ := strconv.Atoi(match[2])
+	return time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+}
+
+func parsePleaseWait(message string) time.Duration {
+	match := pleaseWaitRegex.FindStringSubmatch(message)
+	if len(match) < 2 {
+		return 0
+	}
+	word := strings.ToLower(match[1])
+	minutes, ok := numberWords[word]
+	if !ok {
+		minutes, _ = strconv.Atoi(word)
+	}
+	return time.Duration(minutes) * time.Minute
+}
@@ -0,0 +1,165 @@
+package submit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+)
+
+// Config holds the configuration for submitting an answer to an Advent of Code challenge
+type Config struct {
+	Year   int
+	Day    int
+	Part   int
+	Answer string
+	// WorkDir is used to keep the answer history of the day in its answers.json.
+	// Without it, or if the day has not been created, no history is kept.
+	WorkDir string
+	// Client sends the answer. It needs a session cookie.
+	Client *aoc.Client
+	// Now replaces time.Now when recording answers, e.g. in tests.
+	Now func() time.Time
+	// Reporter shows the progress.
+	Reporter ui.Reporter
+}
+
+type Submitter struct {
+	day    int
+	year   int
+	part   int
+	answer string
+
+	workDir string
+	client  *aoc.Client
+	now     func() time.Time
+
+	reporter ui.Reporter
+}
+
+func NewSubmitter(cfg Config) (*Submitter, error) {
+	s := &Submitter{
+		day:      cfg.Day,
+		year:     cfg.Year,
+		part:     cfg.Part,
+		answer:   strings.TrimSpace(cfg.Answer),
+		workDir:  cfg.WorkDir,
+		client:   cfg.Client,
+		now:      cfg.Now,
+		reporter: cfg.Reporter,
+	}
+	if s.now == nil {
+		s.now = time.Now
+	}
+	if err := s.init(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Submitter) init() error {
+	if s.day <= 0 || s.day > 25 {
+		return fmt.Errorf("%w: %d", ErrInvalidDay, s.day)
+	}
+	if s.year <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidYear, s.year)
+	}
+
+	if s.day > aoc.DaysInYear(s.year) {
+		return fmt.Errorf("%w: %d for year %d", ErrInvalidDay, s.day, s.year)
+	}
+
+	if s.part != 1 && s.part != 2 {
+		return fmt.Errorf("%w: %d", ErrInvalidPart, s.part)
+	}
+
+	if s.answer == "" {
+		return ErrAnswerRequired
+	}
+
+	if s.client == nil || !s.client.HasCookie() {
+		return ErrCookieRequired
+	}
+
+	if s.reporter == nil {
+		return ErrReporterRequired
+	}
+
+	return nil
+}
+
+// Run posts the answer and interprets the reply.
+// A correct answer returns the response; every other verdict is returned as an error.
+// Answers the day's history proves wrong are refused with a RejectedAnswerError
+// before anything is sent, and every verdict is added to the history.
+func (s *Submitter) Run() (*Response, error) {
+	s.reporter.Header("Submitting Advent of Code %d - Day %d - Part %d", s.year, s.day, s.part)
+
+	history, err := s.history()
+	if err != nil {
+		return nil, err
+	}
+	if history != nil {
+		if err := history.Check(s.part, s.answer); err != nil {
+			return nil, err
+		}
+	}
+
+	body, err := s.post()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := Classify(body)
+	if history != nil && resp.isFinal() {
+		if err := history.Record(Attempt{Part: s.part, Answer: s.answer, Verdict: resp.Verdict, Time: s.now()}); err != nil {
+			return nil, err
+		}
+	}
+
+	switch resp.Verdict {
+	case VerdictCorrect:
+		return resp, nil
+	case VerdictTooHigh, VerdictTooLow, VerdictWrong:
+		return nil, NewWrongAnswerError(s.answer, resp.Verdict, resp.Wait)
+	case VerdictWait:
+		return nil, NewWaitError(resp.Wait)
+	case VerdictAlreadySolved:
+		return nil, ErrAlreadySolved
+	default:
+		return nil, ErrUnknownResponse
+	}
+}
+
+// history loads the answer history of the day, or returns nil if none is kept.
+func (s *Submitter) history() (*History, error) {
+	if s.workDir == "" {
+		return nil, nil
+	}
+
+	dayDir := filepath.Join(s.workDir, fmt.Sprintf("y%04d", s.year), fmt.Sprintf("d%02d", s.day))
+	if info, err := os.Stat(dayDir); err != nil || !info.IsDir() {
+		return nil, nil
+	}
+	return LoadHistory(filepath.Join(dayDir, AnswersFile))
+}
+
+func (s *Submitter) post() (string, error) {
+	s.reporter.Info("Sending answer %s", s.answer)
+	body, err := s.client.Submit(s.year, s.day, s.part, s.answer)
+	if err != nil {
+		var requestErr *aoc.RequestError
+		if errors.As(err, &requestErr) {
+			return "", NewSubmitError(requestErr.Reason, requestErr.Status)
+		}
+		return "", err
+	}
+
+	return string(body), nil
+}
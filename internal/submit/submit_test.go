@@ -0,0 +1,194 @@
+package submit
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+)
+
+func newClient(t *testing.T, baseURL, cookie string) *aoc.Client {
+	t.Helper()
+	c, err := aoc.NewClient(aoc.Config{
+		BaseURL:     baseURL,
+		Cookie:      cookie,
+		CacheDir:    t.TempDir(),
+		MinInterval: -1,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return c
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected Verdict
+		wait     time.Duration
+	}{
+		{
+			"Correct",
+			`<main><article><p>That's the right answer!  You are <span class="day-success">one gold star</span> closer.</p></article></main>`,
+			VerdictCorrect,
+			0,
+		},
+		{
+			"Too high",
+			`<article><p>That's not the right answer; your answer is too high.  Please wait one minute before trying again.</p></article>`,
+			VerdictTooHigh,
+			time.Minute,
+		},
+		{
+			"Too low",
+			`<article><p>That's not the right answer; your answer is too low.  please wait 5 minutes before trying again.</p></article>`,
+			VerdictTooLow,
+			5 * time.Minute,
+		},
+		{
+			"Wrong without hint",
+			`<article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data.</p></article>`,
+			VerdictWrong,
+			0,
+		},
+		{
+			"Wait",
+			`<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 23s left to wait.</p></article>`,
+			VerdictWait,
+			83 * time.Second,
+		},
+		{
+			"Wait seconds only",
+			`<article><p>You gave an answer too recently.  You have 45s left to wait.</p></article>`,
+			VerdictWait,
+			45 * time.Second,
+		},
+		{
+			"Already solved",
+			`<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>`,
+			VerdictAlreadySolved,
+			0,
+		},
+		{
+			"Unknown",
+			`<html><body>Something else</body></html>`,
+			VerdictUnknown,
+			0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := Classify(tt.body)
+			if resp.Verdict != tt.expected {
+				t.Errorf("Classify() verdict = %v, want %v", resp.Verdict, tt.expected)
+			}
+			if resp.Wait != tt.wait {
+				t.Errorf("Classify() wait = %v, want %v", resp.Wait, tt.wait)
+			}
+		})
+	}
+}
+
+func TestSubmitter_Run(t *testing.T) {
+	var gotPath, gotLevel, gotAnswer, gotCookie string
+	reply := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotLevel = r.FormValue("level")
+		gotAnswer = r.FormValue("answer")
+		if c, err := r.Cookie("session"); err == nil {
+			gotCookie = c.Value
+		}
+		_, _ = w.Write([]byte(reply))
+	}))
+	defer server.Close()
+
+	newSubmitter := func(t *testing.T) *Submitter {
+		t.Helper()
+		s, err := NewSubmitter(Config{
+			Year:     2024,
+			Day:      5,
+			Part:     2,
+			Answer:   " 42\n",
+			Client:   newClient(t, server.URL+"/", "secret"),
+			Reporter: ui.NewQuiet(io.Discard, io.Discard),
+		})
+		if err != nil {
+			t.Fatalf("NewSubmitter() error = %v", err)
+		}
+		return s
+	}
+
+	t.Run("Correct", func(t *testing.T) {
+		reply = `<article><p>That's the right answer!</p></article>`
+		resp, err := newSubmitter(t).Run()
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		if resp.Verdict != VerdictCorrect {
+			t.Errorf("Run() verdict = %v, want %v", resp.Verdict, VerdictCorrect)
+		}
+		if gotPath != "/2024/day/5/answer" {
+			t.Errorf("path = %q, want %q", gotPath, "/2024/day/5/answer")
+		}
+		if gotLevel != "2" || gotAnswer != "42" || gotCookie != "secret" {
+			t.Errorf("level, answer, cookie = %q, %q, %q, want %q, %q, %q", gotLevel, gotAnswer, gotCookie, "2", "42", "secret")
+		}
+	})
+
+	t.Run("Too low", func(t *testing.T) {
+		reply = `<article><p>That's not the right answer; your answer is too low.</p></article>`
+		_, err := newSubmitter(t).Run()
+		var wrongErr *WrongAnswerError
+		if !errors.As(err, &wrongErr) || wrongErr.Verdict != VerdictTooLow {
+			t.Errorf("Run() error = %v, want WrongAnswerError with verdict %v", err, VerdictTooLow)
+		}
+	})
+
+	t.Run("Wait", func(t *testing.T) {
+		reply = `<article><p>You gave an answer too recently.  You have 30s left to wait.</p></article>`
+		_, err := newSubmitter(t).Run()
+		var waitErr *WaitError
+		if !errors.As(err, &waitErr) || waitErr.Wait != 30*time.Second {
+			t.Errorf("Run() error = %v, want WaitError of 30s", err)
+		}
+	})
+
+	t.Run("Already solved", func(t *testing.T) {
+		reply = `<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>`
+		if _, err := newSubmitter(t).Run(); !errors.Is(err, ErrAlreadySolved) {
+			t.Errorf("Run() error = %v, want %v", err, ErrAlreadySolved)
+		}
+	})
+}
+
+func TestNewSubmitter_Validation(t *testing.T) {
+	c := newClient(t, "", "c")
+	tests := []struct {
+		name     string
+		cfg      Config
+		expected error
+	}{
+		{"Invalid day", Config{Year: 2024, Day: 26, Part: 1, Answer: "1", Client: c}, ErrInvalidDay},
+		{"Day 13 from 2025", Config{Year: 2025, Day: 13, Part: 1, Answer: "1", Client: c}, ErrInvalidDay},
+		{"Invalid part", Config{Year: 2024, Day: 1, Part: 3, Answer: "1", Client: c}, ErrInvalidPart},
+		{"Missing answer", Config{Year: 2024, Day: 1, Part: 1, Answer: "  ", Client: c}, ErrAnswerRequired},
+		{"Missing cookie", Config{Year: 2024, Day: 1, Part: 1, Answer: "1", Client: newClient(t, "", "")}, ErrCookieRequired},
+		{"Missing reporter", Config{Year: 2024, Day: 1, Part: 1, Answer: "1", Client: c}, ErrReporterRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewSubmitter(tt.cfg); !errors.Is(err, tt.expected) {
+				t.Errorf("NewSubmitter() error = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+}
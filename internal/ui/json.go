@@ -0,0 +1,138 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Event kinds of the JSON output
+const (
+	EventHeader      = "header"
+	EventSuccess     = "success"
+	EventError       = "error"
+	EventInfo        = "info"
+	EventWarning     = "warning"
+	EventDetail      = "detail"
+	EventDownload    = "download"
+	EventFileCreated = "file_created"
+	EventDirCreated  = "dir_created"
+	EventAnswer      = "answer"
+	EventValue       = "value"
+	EventTest        = "test"
+	EventCalendar    = "calendar"
+	EventTable       = "table"
+)
+
+// Event is a line of the JSON output. Only the fields of its kind are set.
+type Event struct {
+	Event   string `json:"event"`
+	Message string `json:"message,omitempty"`
+	// Path is absolute, unlike the relative paths shown in the terminal.
+	Path     string        `json:"path,omitempty"`
+	Part     int           `json:"part,omitempty"`
+	Answer   string        `json:"answer,omitempty"`
+	Name     string        `json:"name,omitempty"`
+	Value    string        `json:"value,omitempty"`
+	Status   string        `json:"status,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+	Messages []string      `json:"messages,omitempty"`
+	Headers  []string      `json:"headers,omitempty"`
+	Rows     [][]string    `json:"rows,omitempty"`
+	Days     []CalendarDay `json:"days,omitempty"`
+}
+
+// JSON writes every event as a line of JSON, for editor plugins and scripts
+type JSON struct {
+	enc *json.Encoder
+}
+
+// NewJSON creates a JSON reporter writing to out
+func NewJSON(out io.Writer) *JSON {
+	return &JSON{enc: json.NewEncoder(out)}
+}
+
+func (j *JSON) emit(e Event) {
+	_ = j.enc.Encode(e)
+}
+
+// message emits a text event. The indentation and spacing meant for the terminal is trimmed.
+func (j *JSON) message(event, format string, args ...interface{}) {
+	j.emit(Event{Event: event, Message: strings.TrimSpace(fmt.Sprintf(format, args...))})
+}
+
+func (j *JSON) Header(format string, args ...interface{}) {
+	j.message(EventHeader, format, args...)
+}
+
+func (j *JSON) Success(format string, args ...interface{}) {
+	j.message(EventSuccess, format, args...)
+}
+
+func (j *JSON) Error(format string, args ...interface{}) {
+	j.message(EventError, format, args...)
+}
+
+func (j *JSON) Info(format string, args ...interface{}) {
+	j.message(EventInfo, format, args...)
+}
+
+func (j *JSON) Warning(format string, args ...interface{}) {
+	j.message(EventWarning, format, args...)
+}
+
+func (j *JSON) HighlightInfo(format string, args ...interface{}) {
+	j.message(EventInfo, format, args...)
+}
+
+func (j *JSON) DimText(format string, args ...interface{}) {
+	j.message(EventDetail, format, args...)
+}
+
+func (j *JSON) Download(format string, args ...interface{}) {
+	j.message(EventDownload, format, args...)
+}
+
+func (j *JSON) FileCreated(path string) {
+	j.emit(Event{Event: EventFileCreated, Path: absPath(path)})
+}
+
+func (j *JSON) DirCreated(path string) {
+	j.emit(Event{Event: EventDirCreated, Path: absPath(path)})
+}
+
+func (j *JSON) Answer(part int, answer string, elapsed time.Duration) {
+	j.emit(Event{Event: EventAnswer, Part: part, Answer: answer, Duration: elapsed})
+}
+
+func (j *JSON) Value(name, value string) {
+	j.emit(Event{Event: EventValue, Name: name, Value: value})
+}
+
+func (j *JSON) TestResult(name string, status TestStatus, elapsed time.Duration, messages []string) {
+	j.emit(Event{Event: EventTest, Name: name, Status: status.String(), Duration: elapsed, Messages: messages})
+}
+
+// Countdown is left out, as it is followed by an info event once the wait is over.
+func (j *JSON) Countdown(format string, remaining time.Duration) {}
+
+func (j *JSON) Calendar(days []CalendarDay, columns int) {
+	j.emit(Event{Event: EventCalendar, Days: days})
+}
+
+func (j *JSON) Table(headers []string, rows [][]string) {
+	j.emit(Event{Event: EventTable, Headers: headers, Rows: rows})
+}
+
+func (j *JSON) Clear() {}
+
+// absPath returns path as an absolute path if possible
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// Quiet only shows errors and, without decoration, answers
+type Quiet struct {
+	out      io.Writer
+	terminal *Terminal
+}
+
+// NewQuiet creates a Quiet reporter writing answers to out and errors to errOut
+func NewQuiet(out, errOut io.Writer) *Quiet {
+	return &Quiet{out: out, terminal: NewTerminal(out, errOut)}
+}
+
+func (q *Quiet) Error(format string, args ...interface{}) {
+	q.terminal.Error(format, args...)
+}
+
+func (q *Quiet) Answer(part int, answer string, elapsed time.Duration) {
+	_, _ = fmt.Fprintln(q.out, answer)
+}
+
+func (q *Quiet) Value(name, value string) {
+	_, _ = fmt.Fprintln(q.out, value)
+}
+
+func (q *Quiet) Header(format string, args ...interface{})        {}
+func (q *Quiet) Success(format string, args ...interface{})       {}
+func (q *Quiet) Info(format string, args ...interface{})          {}
+func (q *Quiet) Warning(format string, args ...interface{})       {}
+func (q *Quiet) HighlightInfo(format string, args ...interface{}) {}
+func (q *Quiet) DimText(format string, args ...interface{})       {}
+func (q *Quiet) Download(format string, args ...interface{})      {}
+func (q *Quiet) FileCreated(path string)                          {}
+func (q *Quiet) DirCreated(path string)                           {}
+func (q *Quiet) TestResult(name string, status TestStatus, elapsed time.Duration, messages []string) {
+}
+func (q *Quiet) Countdown(format string, remaining time.Duration) {}
+func (q *Quiet) Calendar(days []CalendarDay, columns int)         {}
+func (q *Quiet) Table(headers []string, rows [][]string)          {}
+func (q *Quiet) Clear()                                           {}
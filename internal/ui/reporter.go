@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Reporter shows the progress and results of a command. Terminal renders them for
+// people, JSON as events for scripts and Quiet only shows errors and answers.
+type Reporter interface {
+	Header(format string, args ...interface{})
+	Success(format string, args ...interface{})
+	Error(format string, args ...interface{})
+	Info(format string, args ...interface{})
+	Warning(format string, args ...interface{})
+	HighlightInfo(format string, args ...interface{})
+	DimText(format string, args ...interface{})
+	Download(format string, args ...interface{})
+	FileCreated(path string)
+	DirCreated(path string)
+	Answer(part int, answer string, elapsed time.Duration)
+	Value(name, value string)
+	TestResult(name string, status TestStatus, elapsed time.Duration, messages []string)
+	Countdown(format string, remaining time.Duration)
+	Calendar(days []CalendarDay, columns int)
+	Table(headers []string, rows [][]string)
+	Clear()
+}
+
+var (
+	_ Reporter = (*Terminal)(nil)
+	_ Reporter = (*JSON)(nil)
+	_ Reporter = (*Quiet)(nil)
+)
+
+// Output formats of NewReporter
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// NewReporter returns the reporter for an output format, writing to stdout and stderr.
+// Quiet only applies to the text format.
+func NewReporter(format string, quiet bool) (Reporter, error) {
+	switch format {
+	case FormatText, "":
+		if quiet {
+			return NewQuiet(os.Stdout, os.Stderr), nil
+		}
+		return NewTerminal(os.Stdout, os.Stderr), nil
+	case FormatJSON:
+		return NewJSON(os.Stdout), nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q (expected %s or %s)", format, FormatText, FormatJSON)
+	}
+}
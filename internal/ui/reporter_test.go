@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestJSON(t *testing.T) {
+	abs, err := filepath.Abs("input.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		report func(r Reporter)
+		want   string
+	}{
+		{
+			name:   "Message",
+			report: func(r Reporter) { r.Warning("Could not download %s", "puzzle") },
+			want:   `{"event":"warning","message":"Could not download puzzle"}` + "\n",
+		},
+		{
+			name:   "Indentation trimmed",
+			report: func(r Reporter) { r.DimText("  Updated registry\n") },
+			want:   `{"event":"detail","message":"Updated registry"}` + "\n",
+		},
+		{
+			name:   "Absolute path",
+			report: func(r Reporter) { r.FileCreated("input.txt") },
+			want:   `{"event":"file_created","path":"` + abs + `"}` + "\n",
+		},
+		{
+			name:   "Answer",
+			report: func(r Reporter) { r.Answer(2, "42", 1500*time.Microsecond) },
+			want:   `{"event":"answer","part":2,"answer":"42","duration":1500000}` + "\n",
+		},
+		{
+			name:   "Value",
+			report: func(r Reporter) { r.Value("year", "2024") },
+			want:   `{"event":"value","name":"year","value":"2024"}` + "\n",
+		},
+		{
+			name:   "Test",
+			report: func(r Reporter) { r.TestResult("example1.txt", TestFailed, 0, []string{"got 1, want 2"}) },
+			want:   `{"event":"test","name":"example1.txt","status":"failed","messages":["got 1, want 2"]}` + "\n",
+		},
+		{
+			name:   "Table",
+			report: func(r Reporter) { r.Table([]string{"Day"}, [][]string{{"1"}}) },
+			want:   `{"event":"table","headers":["Day"],"rows":[["1"]]}` + "\n",
+		},
+		{
+			name: "Terminal only",
+			report: func(r Reporter) {
+				r.Countdown("Puzzle unlocks in %s", time.Minute)
+				r.Clear()
+			},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			tt.report(NewJSON(&out))
+			if got := out.String(); got != tt.want {
+				t.Errorf("JSON output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuiet(t *testing.T) {
+	var out, errOut bytes.Buffer
+	q := NewQuiet(&out, &errOut)
+
+	q.Header("Running Advent of Code %d - Day %d", 2024, 5)
+	q.FileCreated("input.txt")
+	q.Answer(1, "42", time.Millisecond)
+	q.Value("year", "2024")
+	q.Error("part %d failed", 2)
+
+	if got, want := out.String(), "42\n2024\n"; got != want {
+		t.Errorf("Quiet output = %q, want %q", got, want)
+	}
+	if !bytes.Contains(errOut.Bytes(), []byte("part 2 failed")) {
+		t.Errorf("Quiet errors = %q, want the error", errOut.String())
+	}
+}
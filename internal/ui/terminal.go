@@ -0,0 +1,236 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+)
+
+// Terminal renders output with colors and icons for people
+type Terminal struct {
+	out    io.Writer
+	errOut io.Writer
+}
+
+// NewTerminal creates a Terminal writing errors to errOut and everything else to out
+func NewTerminal(out, errOut io.Writer) *Terminal {
+	return &Terminal{out: out, errOut: errOut}
+}
+
+// Success prints a success message with icon
+func (t *Terminal) Success(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	icon := successStyle.Render(successIcon)
+	text := successStyle.Render(message)
+	_, _ = fmt.Fprintf(t.out, "\n%s %s\n", icon, text)
+}
+
+// Error prints an error message with icon
+func (t *Terminal) Error(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	icon := errorStyle.Render(errorIcon)
+	text := errorStyle.Render(message)
+	_, _ = fmt.Fprintf(t.errOut, "%s %s\n", icon, text)
+}
+
+// Info prints an info message with icon
+func (t *Terminal) Info(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	icon := infoStyle.Render(infoIcon)
+	text := infoStyle.Render(message)
+	_, _ = fmt.Fprintf(t.out, "%s %s\n", icon, text)
+}
+
+// Warning prints a warning message with icon
+func (t *Terminal) Warning(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	icon := warningStyle.Render(warningIcon)
+	text := warningStyle.Render(message)
+	_, _ = fmt.Fprintf(t.out, "%s %s\n", icon, text)
+}
+
+// FileCreated prints a message for a created file
+func (t *Terminal) FileCreated(path string) {
+	icon := successStyle.Render(successIcon)
+	fileIconStyled := lipgloss.NewStyle().Foreground(fileColor).Bold(true).Render(fileIcon)
+	relPath := MakeRelative(path)
+	pathStyled := fileStyle.Render(relPath)
+	_, _ = fmt.Fprintf(t.out, "  %s %s %s\n\n", icon, fileIconStyled, pathStyled)
+}
+
+// DirCreated prints a message for a created directory
+func (t *Terminal) DirCreated(path string) {
+	icon := successStyle.Render(successIcon)
+	dirIconStyled := lipgloss.NewStyle().Foreground(fileColor).Bold(true).Render(dirIcon)
+	relPath := MakeRelative(path)
+	pathStyled := fileStyle.Render(relPath)
+	_, _ = fmt.Fprintf(t.out, "  %s %s %s\n\n", icon, dirIconStyled, pathStyled)
+}
+
+// Download prints a download message
+func (t *Terminal) Download(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	icon := lipgloss.NewStyle().Foreground(downloadColor).Bold(true).Render(downloadIcon)
+	text := lipgloss.NewStyle().Foreground(downloadColor).Render(message)
+	_, _ = fmt.Fprintf(t.out, "  %s %s\n\n", icon, text)
+}
+
+// Answer prints the answer of a puzzle part together with the time it took
+func (t *Terminal) Answer(part int, answer string, elapsed time.Duration) {
+	icon := warningStyle.Render(answerIcon)
+	label := infoStyle.Render(fmt.Sprintf("Part %d:", part))
+	text := successStyle.Render(answer)
+	timing := dimStyle.Render(fmt.Sprintf("(%s)", elapsed.Round(time.Microsecond)))
+	_, _ = fmt.Fprintf(t.out, "  %s %s %s %s\n", icon, label, text, timing)
+}
+
+// Value prints a single value without decoration, so scripts can use it
+func (t *Terminal) Value(name, value string) {
+	_, _ = fmt.Fprintln(t.out, value)
+}
+
+// TestResult prints the outcome of a test together with the time it took and,
+// dimmed below it, what the test logged
+func (t *Terminal) TestResult(name string, status TestStatus, elapsed time.Duration, messages []string) {
+	var icon, text string
+	switch status {
+	case TestPassed:
+		icon, text = successStyle.Render(successIcon), successStyle.Render("passed")
+	case TestSkipped:
+		icon, text = warningStyle.Render(warningIcon), warningStyle.Render("skipped")
+	default:
+		icon, text = errorStyle.Render(errorIcon), errorStyle.Render("failed")
+	}
+	label := infoStyle.Render(name)
+	timing := dimStyle.Render(fmt.Sprintf("(%s)", elapsed.Round(time.Microsecond)))
+	_, _ = fmt.Fprintf(t.out, "  %s %s %s %s\n", icon, label, text, timing)
+	for _, message := range messages {
+		_, _ = fmt.Fprintf(t.out, "      %s\n", dimStyle.Render(message))
+	}
+}
+
+// Clear clears the terminal and moves the cursor to the top
+func (t *Terminal) Clear() {
+	_, _ = fmt.Fprint(t.out, "\033[H\033[2J")
+}
+
+// Countdown prints the remaining time on a single line, replacing the previous countdown.
+// A remaining time of zero or less ends the line.
+func (t *Terminal) Countdown(format string, remaining time.Duration) {
+	if remaining <= 0 {
+		_, _ = fmt.Fprintf(t.out, "\n\n")
+		return
+	}
+
+	icon := infoStyle.Render(waitIcon)
+	text := infoStyle.Render(fmt.Sprintf(format, formatRemaining(remaining)))
+	// \r and the erase-line sequence overwrite the previous countdown
+	_, _ = fmt.Fprintf(t.out, "\r\033[K  %s %s", icon, text)
+}
+
+// Calendar prints days as a grid of cells with the given number of columns
+func (t *Terminal) Calendar(days []CalendarDay, columns int) {
+	cellStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(dimColor).
+		Width(12).
+		Padding(0, 1)
+
+	var rows []string
+	var cells []string
+	for i, day := range days {
+		title := fmt.Sprintf("Day %02d", day.Day)
+		var lines []string
+		if day.Exists {
+			lines = append(lines, lipgloss.NewStyle().Bold(true).Render(title))
+		} else {
+			lines = append(lines, dimStyle.Render(title))
+		}
+
+		var stars string
+		for part := 1; part <= 2; part++ {
+			switch {
+			case part <= day.Stars:
+				stars += warningStyle.Render(answerIcon)
+			case part <= day.Solved:
+				stars += successStyle.Render(answerIcon)
+			default:
+				stars += dimStyle.Render("·")
+			}
+		}
+		lines = append(lines, stars, dimStyle.Render(day.Detail), warningStyle.Render(day.Warning))
+
+		cells = append(cells, cellStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...)))
+		if len(cells) == columns || i == len(days)-1 {
+			rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+			cells = nil
+		}
+	}
+
+	_, _ = fmt.Fprintf(t.out, "%s\n", lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+// Table prints rows as a table below a header row
+func (t *Terminal) Table(headers []string, rows [][]string) {
+	cellStyle := lipgloss.NewStyle().Padding(0, 1)
+	tbl := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(dimStyle).
+		Headers(headers...).
+		Rows(rows...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return infoStyle.Padding(0, 1)
+			}
+			return cellStyle
+		})
+	_, _ = fmt.Fprintf(t.out, "%s\n", tbl.Render())
+}
+
+// DimText prints dimmed text
+func (t *Terminal) DimText(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	styled := dimStyle.Render(message)
+	_, _ = fmt.Fprintf(t.out, "%s\n", styled)
+}
+
+// Header prints a styled header with a border
+func (t *Terminal) Header(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	headerStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("15")). // White
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("6")). // Cyan border
+		Padding(0, 1).
+		Margin(1, 0)
+
+	styled := headerStyle.Render(message)
+	_, _ = fmt.Fprintf(t.out, "\n%s\n", styled)
+}
+
+// HighlightInfo prints a highly visible info message with extra styling
+func (t *Terminal) HighlightInfo(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	icon := infoStyle.Render(infoIcon)
+	// Make it more prominent with bold, brighter color, and spacing
+	highlightStyle := lipgloss.NewStyle().
+		Foreground(infoColor).
+		Bold(true)
+	text := highlightStyle.Render(message)
+	_, _ = fmt.Fprintf(t.out, "\n%s %s\n", icon, text)
+}
+
+// formatRemaining formats a remaining time as hh:mm:ss, with days in front if needed
+func formatRemaining(remaining time.Duration) string {
+	remaining = remaining.Round(time.Second)
+	hours := int(remaining.Hours())
+	clock := fmt.Sprintf("%02d:%02d:%02d", hours%24, int(remaining.Minutes())%60, int(remaining.Seconds())%60)
+	if hours >= 24 {
+		clock = fmt.Sprintf("%dd %s", hours/24, clock)
+	}
+	return clock
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -35,8 +36,13 @@ var (
 	fileIcon     = "📄"
 	dirIcon      = "📁"
 	downloadIcon = "⬇"
+	answerIcon   = "★"
+	waitIcon     = "⏳"
 )
 
+// ErrCancelled is returned when the user declines or interrupts a prompt
+var ErrCancelled = errors.New("operation cancelled by user")
+
 // MakeRelative converts an absolute path to a relative path from the current working directory
 func MakeRelative(path string) string {
 	wd, err := os.Getwd()
@@ -53,96 +59,37 @@ func MakeRelative(path string) string {
 	return "./" + rel
 }
 
-// Success prints a success message with icon
-func Success(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	icon := successStyle.Render(successIcon)
-	text := successStyle.Render(message)
-	_, _ = fmt.Fprintf(os.Stdout, "\n%s %s\n", icon, text)
-}
-
-// Error prints an error message with icon
-func Error(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	icon := errorStyle.Render(errorIcon)
-	text := errorStyle.Render(message)
-	_, _ = fmt.Fprintf(os.Stderr, "%s %s\n", icon, text)
-}
-
-// Info prints an info message with icon
-func Info(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	icon := infoStyle.Render(infoIcon)
-	text := infoStyle.Render(message)
-	_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", icon, text)
-}
-
-// Warning prints a warning message with icon
-func Warning(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	icon := warningStyle.Render(warningIcon)
-	text := warningStyle.Render(message)
-	_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", icon, text)
-}
-
-// FileCreated prints a message for a created file
-func FileCreated(path string) {
-	icon := successStyle.Render(successIcon)
-	fileIconStyled := lipgloss.NewStyle().Foreground(fileColor).Bold(true).Render(fileIcon)
-	relPath := MakeRelative(path)
-	pathStyled := fileStyle.Render(relPath)
-	_, _ = fmt.Fprintf(os.Stdout, "  %s %s %s\n\n", icon, fileIconStyled, pathStyled)
-}
-
-// DirCreated prints a message for a created directory
-func DirCreated(path string) {
-	icon := successStyle.Render(successIcon)
-	dirIconStyled := lipgloss.NewStyle().Foreground(fileColor).Bold(true).Render(dirIcon)
-	relPath := MakeRelative(path)
-	pathStyled := fileStyle.Render(relPath)
-	_, _ = fmt.Fprintf(os.Stdout, "  %s %s %s\n\n", icon, dirIconStyled, pathStyled)
-}
+// TestStatus is the outcome of a test shown by TestResult
+type TestStatus int
 
-// Download prints a download message
-func Download(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	icon := lipgloss.NewStyle().Foreground(downloadColor).Bold(true).Render(downloadIcon)
-	text := lipgloss.NewStyle().Foreground(downloadColor).Render(message)
-	_, _ = fmt.Fprintf(os.Stdout, "  %s %s\n\n", icon, text)
-}
-
-// DimText prints dimmed text
-func DimText(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	styled := dimStyle.Render(message)
-	_, _ = fmt.Fprintf(os.Stdout, "%s\n", styled)
-}
+const (
+	TestPassed TestStatus = iota
+	TestFailed
+	TestSkipped
+)
 
-// Header prints a styled header with a border
-func Header(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	headerStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("15")). // White
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("6")). // Cyan border
-		Padding(0, 1).
-		Margin(1, 0)
-
-	styled := headerStyle.Render(message)
-	_, _ = fmt.Fprintf(os.Stdout, "\n%s\n", styled)
+func (s TestStatus) String() string {
+	switch s {
+	case TestPassed:
+		return "passed"
+	case TestSkipped:
+		return "skipped"
+	default:
+		return "failed"
+	}
 }
 
-// HighlightInfo prints a highly visible info message with extra styling
-func HighlightInfo(format string, args ...interface{}) {
-	message := fmt.Sprintf(format, args...)
-	icon := infoStyle.Render(infoIcon)
-	// Make it more prominent with bold, brighter color, and spacing
-	highlightStyle := lipgloss.NewStyle().
-		Foreground(infoColor).
-		Bold(true)
-	text := highlightStyle.Render(message)
-	_, _ = fmt.Fprintf(os.Stdout, "\n%s %s\n", icon, text)
+// CalendarDay is a cell of Calendar
+type CalendarDay struct {
+	Day int `json:"day"`
+	// Exists is false for days that have not been created; they are dimmed.
+	Exists bool `json:"exists"`
+	// Stars are the stars earned on adventofcode.com, Solved the parts solved locally.
+	Stars  int `json:"stars"`
+	Solved int `json:"solved"`
+	// Detail is a dimmed line, e.g. a timing, and Warning a highlighted one, e.g. a missing input.
+	Detail  string `json:"detail,omitempty"`
+	Warning string `json:"warning,omitempty"`
 }
 
 // ConfirmOverwrite prompts the user to confirm if they want to delete and recreate existing files
@@ -151,6 +98,8 @@ func ConfirmOverwrite(year, day int, relPath string) (bool, error) {
 		Label:     fmt.Sprintf("Day %d of %d already exists at %s. Delete and recreate? (y/N)", day, year, relPath),
 		IsConfirm: true,
 		Default:   "N",
+		// Prompts go to stderr, so they never end up between the output of the reporter
+		Stdout: os.Stderr,
 	}
 
 	result, err := prompt.Run()
@@ -163,7 +112,47 @@ func ConfirmOverwrite(year, day int, relPath string) (bool, error) {
 	}
 
 	// Add spacing after prompt response
-	_, _ = fmt.Fprintf(os.Stdout, "\n")
+	_, _ = fmt.Fprintf(os.Stderr, "\n")
 
 	return result == "y" || result == "Y" || result == "yes" || result == "Yes", nil
 }
+
+// Confirm asks the user a yes/no question, defaulting to no
+func Confirm(label string) (bool, error) {
+	prompt := promptui.Prompt{
+		Label:     label,
+		IsConfirm: true,
+		Default:   "N",
+		Stdout:    os.Stderr,
+	}
+
+	result, err := prompt.Run()
+	if err != nil {
+		// User cancelled or said no
+		if err == promptui.ErrInterrupt || err == promptui.ErrAbort {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return result == "y" || result == "Y" || result == "yes" || result == "Yes", nil
+}
+
+// PromptSecret asks the user for a value without echoing it
+func PromptSecret(label string) (string, error) {
+	prompt := promptui.Prompt{
+		Label:  label,
+		Mask:   '*',
+		Stdout: os.Stderr,
+	}
+
+	result, err := prompt.Run()
+	if err != nil {
+		if err == promptui.ErrInterrupt || err == promptui.ErrAbort {
+			return "", ErrCancelled
+		}
+		return "", err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,30 @@
+package watch
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Domain-specific errors
+var (
+	ErrInvalidDay       = errors.New("invalid day")
+	ErrInvalidYear      = errors.New("invalid year")
+	ErrInvalidPart      = errors.New("invalid part")
+	ErrWorkdirRequired  = errors.New("workdir is required")
+	ErrReporterRequired = errors.New("reporter is required")
+)
+
+// DayNotFoundError represents a day that has not been created yet
+type DayNotFoundError struct {
+	Year int
+	Day  int
+}
+
+func (e *DayNotFoundError) Error() string {
+	return fmt.Sprintf("day %d of %d does not exist - create it first", e.Day, e.Year)
+}
+
+// NewDayNotFoundError creates a new DayNotFoundError
+func NewDayNotFoundError(year, day int) *DayNotFoundError {
+	return &DayNotFoundError{Year: year, Day: day}
+}
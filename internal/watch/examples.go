@@ -0,0 +1,131 @@
+package watch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Case is the result of a single example of a part, e.g. TestPartOne/testdata/example1.txt.
+type Case struct {
+	Part int
+	Name string
+	// Action is how the test ended: "pass", "fail" or "skip".
+	Action  string
+	Elapsed time.Duration
+	// Messages are what the test logged, e.g. the got and want of a failure.
+	Messages []string
+}
+
+// Report is the outcome of running the examples of a day
+type Report struct {
+	Cases []Case
+	// BuildErrors are the compiler messages if the day does not build, without the go test noise.
+	BuildErrors []string
+}
+
+// testEvent is a line of go test -json
+type testEvent struct {
+	Action  string
+	Test    string
+	Output  string
+	Elapsed float64
+}
+
+// partTests maps the tests generated for the examples of the puzzle description to their part
+var partTests = map[string]int{
+	"TestPartOne": 1,
+	"TestPartTwo": 2,
+}
+
+// parseTestEvents reads the output of go test -json. Tests with subtests are
+// left out, so every example is reported on its own.
+func parseTestEvents(data []byte) (*Report, error) {
+	report := &Report{}
+	cases := make(map[string]*Case)
+	var order []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var event testEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			// go test prints a few plain lines, e.g. when the package can't be loaded
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				report.BuildErrors = append(report.BuildErrors, compileErrors(line)...)
+			}
+			continue
+		}
+
+		if event.Action == "build-output" {
+			report.BuildErrors = append(report.BuildErrors, compileErrors(event.Output)...)
+			continue
+		}
+		if event.Test == "" {
+			continue
+		}
+
+		root, _, _ := strings.Cut(event.Test, "/")
+		part, ok := partTests[root]
+		if !ok {
+			continue
+		}
+
+		c, ok := cases[event.Test]
+		if !ok {
+			c = &Case{Part: part, Name: event.Test}
+			cases[event.Test] = c
+			order = append(order, event.Test)
+		}
+
+		switch event.Action {
+		case "output":
+			if message := testMessage(event.Output); message != "" {
+				c.Messages = append(c.Messages, message)
+			}
+		case "pass", "fail", "skip":
+			c.Action = event.Action
+			c.Elapsed = time.Duration(event.Elapsed * float64(time.Second))
+		}
+	}
+
+	for _, name := range order {
+		if hasSubtests(name, order) {
+			continue
+		}
+		report.Cases = append(report.Cases, *cases[name])
+	}
+	return report, scanner.Err()
+}
+
+func hasSubtests(name string, tests []string) bool {
+	for _, test := range tests {
+		if strings.HasPrefix(test, name+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// testMessage returns a line logged by a test, or "" for the status lines of go test.
+func testMessage(output string) string {
+	line := strings.TrimSpace(output)
+	if strings.HasPrefix(line, "=== ") || strings.HasPrefix(line, "--- ") {
+		return ""
+	}
+	return line
+}
+
+// compileErrors drops the package headers and blank lines from compiler output.
+func compileErrors(output string) []string {
+	var lines []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" || strings.HasPrefix(line, "# ") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
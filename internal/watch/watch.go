@@ -0,0 +1,315 @@
+// Package watch re-runs a day whenever one of its files is saved.
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/frederik-suerig/advent-of-code/internal/aoc"
+	"github.com/frederik-suerig/advent-of-code/internal/runner"
+	"github.com/frederik-suerig/advent-of-code/internal/ui"
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultDebounce is how long to wait for further changes before re-running.
+// Editors often write a file in several steps, and formatters touch it again right after.
+const DefaultDebounce = 200 * time.Millisecond
+
+// Config holds the configuration for watching an Advent of Code challenge
+type Config struct {
+	Year int
+	Day  int
+	// Part is the part to run, or 0 to run both parts.
+	Part    int
+	WorkDir string
+	// Debounce overrides DefaultDebounce.
+	Debounce time.Duration
+	// Stdout receives what the solution prints, see runner.Config.
+	Stdout io.Writer
+	// Reporter shows the results.
+	Reporter ui.Reporter
+}
+
+type Watcher struct {
+	day  int
+	year int
+	part int
+
+	debounce time.Duration
+	stdout   io.Writer
+	reporter ui.Reporter
+
+	workDir string
+	dayDir  string
+	runner  *runner.Runner
+}
+
+func NewWatcher(cfg Config) (*Watcher, error) {
+	w := &Watcher{
+		day:      cfg.Day,
+		year:     cfg.Year,
+		part:     cfg.Part,
+		workDir:  cfg.WorkDir,
+		debounce: cfg.Debounce,
+		stdout:   cfg.Stdout,
+		reporter: cfg.Reporter,
+	}
+	if w.debounce <= 0 {
+		w.debounce = DefaultDebounce
+	}
+	if err := w.init(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *Watcher) init() error {
+	if w.day <= 0 || w.day > 25 {
+		return fmt.Errorf("%w: %d", ErrInvalidDay, w.day)
+	}
+	if w.year <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidYear, w.year)
+	}
+	if w.day > aoc.DaysInYear(w.year) {
+		return fmt.Errorf("%w: %d for year %d", ErrInvalidDay, w.day, w.year)
+	}
+	if w.part < 0 || w.part > 2 {
+		return fmt.Errorf("%w: %d", ErrInvalidPart, w.part)
+	}
+
+	if w.workDir == "" {
+		return ErrWorkdirRequired
+	}
+
+	if w.reporter == nil {
+		return ErrReporterRequired
+	}
+
+	w.dayDir = filepath.Join(
+		w.workDir,
+		fmt.Sprintf("y%04d", w.year),
+		fmt.Sprintf("d%02d", w.day),
+	)
+
+	// The solution changes while watching, so it is always compiled from source
+	r, err := runner.NewRunner(runner.Config{
+		Year:    w.year,
+		Day:     w.day,
+		Part:    w.part,
+		WorkDir: w.workDir,
+		Rebuild: true,
+		Stdout:  w.stdout,
+	})
+	if err != nil {
+		return err
+	}
+	w.runner = r
+
+	return nil
+}
+
+// Run checks the day once and again after every change to its Go files or inputs,
+// until ctx is cancelled. Failing examples and compile errors are shown, not returned.
+func (w *Watcher) Run(ctx context.Context) error {
+	if info, err := os.Stat(w.dayDir); err != nil || !info.IsDir() {
+		return NewDayNotFoundError(w.year, w.day)
+	}
+
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to start watching: %w", err)
+	}
+	defer func() {
+		_ = fsw.Close()
+	}()
+
+	if err := w.addDirs(fsw, w.dayDir); err != nil {
+		return err
+	}
+
+	w.check()
+
+	var timer *time.Timer
+	var fire <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-fsw.Events:
+			if !ok {
+				return nil
+			}
+			// New directories, e.g. testdata, are watched as well
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					if err := w.addDirs(fsw, event.Name); err != nil {
+						return err
+					}
+					continue
+				}
+			}
+			if !relevant(event.Name) {
+				continue
+			}
+			if timer == nil {
+				timer = time.NewTimer(w.debounce)
+			} else {
+				timer.Reset(w.debounce)
+			}
+			fire = timer.C
+		case err, ok := <-fsw.Errors:
+			if !ok {
+				return nil
+			}
+			return fmt.Errorf("failed to watch %s: %w", w.dayDir, err)
+		case <-fire:
+			fire = nil
+			w.check()
+		}
+	}
+}
+
+// addDirs watches dir and all directories below it, except hidden ones.
+func (w *Watcher) addDirs(fsw *fsnotify.Watcher, dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+		if err := fsw.Add(path); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// relevant returns true if a change to the file can change the result of the day.
+// Hidden files and editor backups are ignored.
+func relevant(path string) bool {
+	name := filepath.Base(path)
+	if strings.HasPrefix(name, ".") || strings.HasSuffix(name, "~") {
+		return false
+	}
+	switch filepath.Ext(name) {
+	case ".go", ".txt":
+		return true
+	}
+	return false
+}
+
+// check clears the screen, runs the examples and, if they build, the real input.
+func (w *Watcher) check() {
+	w.reporter.Clear()
+	w.reporter.Header("Watching Advent of Code %d - Day %d", w.year, w.day)
+
+	report, err := w.runExamples()
+	if err != nil {
+		w.reporter.Error("%s", err)
+		w.waiting()
+		return
+	}
+
+	if len(report.BuildErrors) > 0 {
+		w.reporter.Error("Build failed")
+		for _, line := range report.BuildErrors {
+			w.reporter.DimText("  %s", line)
+		}
+		w.waiting()
+		return
+	}
+
+	for _, part := range w.runner.Parts() {
+		ran := false
+		for _, c := range report.Cases {
+			if c.Part != part {
+				continue
+			}
+			ran = true
+			w.reporter.TestResult(fmt.Sprintf("Part %d: %s", part, exampleName(c.Name)), testStatus(c.Action), c.Elapsed, c.Messages)
+		}
+		if !ran {
+			w.reporter.DimText("  No examples for part %d", part)
+		}
+	}
+
+	results, err := w.runner.Run()
+	for _, res := range results {
+		w.reporter.Answer(res.Part, res.Answer, res.Duration)
+	}
+	if err != nil {
+		var buildErr *runner.BuildError
+		if errors.As(err, &buildErr) {
+			w.reporter.Error("Build failed")
+			for _, line := range compileErrors(buildErr.Output) {
+				w.reporter.DimText("  %s", line)
+			}
+		} else {
+			w.reporter.Error("%s", err)
+		}
+	}
+
+	w.waiting()
+}
+
+func (w *Watcher) waiting() {
+	w.reporter.DimText("\nWatching %s for changes, press Ctrl+C to stop", ui.MakeRelative(w.dayDir))
+}
+
+// runExamples runs the tests generated for the examples of the selected parts.
+func (w *Watcher) runExamples() (*Report, error) {
+	rel, err := filepath.Rel(w.workDir, w.dayDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve package path: %w", err)
+	}
+
+	var names []string
+	for name, part := range partTests {
+		if w.part == 0 || part == w.part {
+			names = append(names, name)
+		}
+	}
+
+	cmd := exec.Command("go", "test", "-json", "-run", "^("+strings.Join(names, "|")+")$", "./"+filepath.ToSlash(rel))
+	cmd.Dir = w.workDir
+	// Failing tests make go test exit with an error, they are read from the output instead
+	output, err := cmd.CombinedOutput()
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return nil, fmt.Errorf("failed to run go test: %w", err)
+	}
+
+	return parseTestEvents(output)
+}
+
+// exampleName returns the name of an example's subtest, which is the path of its input.
+func exampleName(test string) string {
+	if _, name, ok := strings.Cut(test, "/"); ok {
+		return name
+	}
+	return test
+}
+
+func testStatus(action string) ui.TestStatus {
+	switch action {
+	case "pass":
+		return ui.TestPassed
+	case "skip":
+		return ui.TestSkipped
+	default:
+		return ui.TestFailed
+	}
+}
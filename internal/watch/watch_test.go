@@ -0,0 +1,101 @@
+package watch
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTestEvents(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []string
+		want   *Report
+	}{
+		{
+			name: "examples",
+			events: []string{
+				`{"Action":"start","Package":"example.com/y2024/d05"}`,
+				`{"Action":"run","Package":"example.com/y2024/d05","Test":"TestPartOne"}`,
+				`{"Action":"output","Package":"example.com/y2024/d05","Test":"TestPartOne","Output":"=== RUN   TestPartOne\n"}`,
+				`{"Action":"run","Package":"example.com/y2024/d05","Test":"TestPartOne/testdata/example1.txt"}`,
+				`{"Action":"output","Package":"example.com/y2024/d05","Test":"TestPartOne/testdata/example1.txt","Output":"    --- PASS: TestPartOne/testdata/example1.txt (0.00s)\n"}`,
+				`{"Action":"pass","Package":"example.com/y2024/d05","Test":"TestPartOne/testdata/example1.txt","Elapsed":0.25}`,
+				`{"Action":"run","Package":"example.com/y2024/d05","Test":"TestPartOne/testdata/example2.txt"}`,
+				`{"Action":"output","Package":"example.com/y2024/d05","Test":"TestPartOne/testdata/example2.txt","Output":"    part1_test.go:36: PartOne() = \"1\", want \"2\"\n"}`,
+				`{"Action":"fail","Package":"example.com/y2024/d05","Test":"TestPartOne/testdata/example2.txt","Elapsed":0}`,
+				`{"Action":"fail","Package":"example.com/y2024/d05","Test":"TestPartOne","Elapsed":0.25}`,
+				`{"Action":"run","Package":"example.com/y2024/d05","Test":"TestPartTwo/testdata/example1.txt"}`,
+				`{"Action":"output","Package":"example.com/y2024/d05","Test":"TestPartTwo/testdata/example1.txt","Output":"    part2_test.go:33: expected answer unknown, got \"3\"\n"}`,
+				`{"Action":"skip","Package":"example.com/y2024/d05","Test":"TestPartTwo/testdata/example1.txt","Elapsed":0}`,
+				`{"Action":"run","Package":"example.com/y2024/d05","Test":"TestHelper"}`,
+				`{"Action":"pass","Package":"example.com/y2024/d05","Test":"TestHelper","Elapsed":0}`,
+				`{"Action":"output","Package":"example.com/y2024/d05","Output":"FAIL\n"}`,
+				`{"Action":"fail","Package":"example.com/y2024/d05","Elapsed":0.3}`,
+			},
+			want: &Report{Cases: []Case{
+				{Part: 1, Name: "TestPartOne/testdata/example1.txt", Action: "pass", Elapsed: 250 * time.Millisecond},
+				{Part: 1, Name: "TestPartOne/testdata/example2.txt", Action: "fail", Messages: []string{`part1_test.go:36: PartOne() = "1", want "2"`}},
+				{Part: 2, Name: "TestPartTwo/testdata/example1.txt", Action: "skip", Messages: []string{`part2_test.go:33: expected answer unknown, got "3"`}},
+			}},
+		},
+		{
+			name: "build failure",
+			events: []string{
+				`{"ImportPath":"example.com/y2024/d05","Action":"build-output","Output":"# example.com/y2024/d05\n"}`,
+				`{"ImportPath":"example.com/y2024/d05","Action":"build-output","Output":"y2024/d05/solution.go:18:40: undefined: x\n"}`,
+				`{"ImportPath":"example.com/y2024/d05","Action":"build-fail"}`,
+				`{"Action":"start","Package":"example.com/y2024/d05"}`,
+				`{"Action":"output","Package":"example.com/y2024/d05","Output":"FAIL\texample.com/y2024/d05 [build failed]\n"}`,
+				`{"Action":"fail","Package":"example.com/y2024/d05","Elapsed":0,"FailedBuild":"example.com/y2024/d05"}`,
+			},
+			want: &Report{BuildErrors: []string{"y2024/d05/solution.go:18:40: undefined: x"}},
+		},
+		{
+			name:   "no examples",
+			events: []string{`{"Action":"output","Package":"example.com/y2024/d05","Output":"testing: warning: no tests to run\n"}`},
+			want:   &Report{},
+		},
+		{
+			name:   "plain output",
+			events: []string{"pattern ./y2024/d05: directory prefix y2024/d05 does not contain main module"},
+			want:   &Report{BuildErrors: []string{"pattern ./y2024/d05: directory prefix y2024/d05 does not contain main module"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTestEvents([]byte(strings.Join(tt.events, "\n")))
+			if err != nil {
+				t.Fatalf("parseTestEvents() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseTestEvents() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelevant(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"y2024/d05/solution.go", true},
+		{"y2024/d05/part1_test.go", true},
+		{"y2024/d05/testdata/input.txt", true},
+		{"y2024/d05/README.md", false},
+		{"y2024/d05/.solution.go.swp", false},
+		{"y2024/d05/solution.go~", false},
+		{"y2024/d05/answers.json", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := relevant(tt.path); got != tt.want {
+				t.Errorf("relevant(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
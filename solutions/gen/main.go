@@ -0,0 +1,24 @@
+// Command gen regenerates the solution registry from the days that exist on disk.
+// It deliberately does not import the registry, so it also works when the registry
+// refers to a day that was deleted.
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/frederik-suerig/advent-of-code/internal/create"
+)
+
+func main() {
+	workDir := flag.String("workdir", ".", "Your Advent of Code working directory")
+	flag.Parse()
+
+	skipped, err := create.UpdateRegistry(*workDir)
+	if err != nil {
+		log.Fatalf("could not update registry: %v", err)
+	}
+	for _, reason := range skipped {
+		log.Printf("%v - left out of the solution registry", reason)
+	}
+}
@@ -0,0 +1,6 @@
+//go:build !registry
+
+package solutions
+
+// registry is empty unless the binary is built with the registry tag, see registry_gen.go.
+var registry = map[Key]Func{}
@@ -0,0 +1,56 @@
+// Package solutions gives access to the solutions of all days in this repository,
+// so they can be run from a single binary.
+//
+// The registry itself lives in registry_gen.go, which is regenerated by the create
+// command. After deleting a day by hand, regenerate it with:
+//
+//	go generate ./solutions
+//
+// The registry imports every day, so a single day that doesn't compile would break the
+// whole binary. It is therefore only built in with the registry build tag:
+//
+//	go build -tags registry -o aoc .
+//
+// Without it, the registry is empty and every day is compiled on its own when run.
+package solutions
+
+import (
+	"io"
+	"sort"
+)
+
+//go:generate go run ./gen -workdir ..
+
+// Func is the signature of PartOne and PartTwo of every day.
+type Func func(io.Reader, io.Writer) error
+
+// Key identifies a single part of a puzzle.
+type Key struct {
+	Year int
+	Day  int
+	Part int
+}
+
+// Lookup returns the solution of the given part and true if it is registered.
+func Lookup(year, day, part int) (Func, bool) {
+	solve, ok := registry[Key{Year: year, Day: day, Part: part}]
+	return solve, ok
+}
+
+// Keys returns all registered parts, sorted by year, day and part.
+func Keys() []Key {
+	keys := make([]Key, 0, len(registry))
+	for key := range registry {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Year != keys[j].Year {
+			return keys[i].Year < keys[j].Year
+		}
+		if keys[i].Day != keys[j].Day {
+			return keys[i].Day < keys[j].Day
+		}
+		return keys[i].Part < keys[j].Part
+	})
+	return keys
+}